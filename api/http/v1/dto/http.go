@@ -37,4 +37,39 @@ type PushStats struct {
 	Honor   PushStat `json:"honor"`   // 荣耀平台推送状态
 	HTTP    PushStat `json:"http"`    // HTTP 推送状态
 	GRPC    PushStat `json:"pb"`      // GRPC 推送状态
+
+	// Quota 配置了限流的平台及应用当天的配额使用情况
+	Quota []QuotaStat `json:"quota"`
+
+	// Breakers 启用了熔断的应用的熔断器状态
+	Breakers []BreakerStat `json:"breakers"`
+}
+
+// QuotaStat 平台或应用的限流配置及当天的配额使用情况
+type QuotaStat struct {
+	Platform   string  `json:"platform"`         // 推送平台
+	AppID      string  `json:"app_id,omitempty"` // 应用 ID，为空时为平台级别的限制
+	QPS        float64 `json:"qps"`              // 每秒调用厂商接口的次数
+	Burst      int     `json:"burst"`            // 令牌桶容量
+	DailyQuota int64   `json:"daily_quota"`      // 每日推送设备数量上限，0 表示不限制
+	Used       int64   `json:"used"`             // 当天已使用的配额
+	Remaining  int64   `json:"remaining"`        // 当天剩余的配额，不限制时为 -1
+}
+
+// BreakerStat 应用的熔断器状态
+type BreakerStat struct {
+	Platform            string `json:"platform"`             // 推送平台
+	AppID               string `json:"app_id"`               // 应用 ID
+	State               string `json:"state"`                // 熔断器状态 closed、open、half_open
+	Requests            int    `json:"requests"`             // 统计窗口内的请求数
+	Failures            int    `json:"failures"`             // 统计窗口内的失败数
+	ConsecutiveFailures int    `json:"consecutive_failures"` // 连续失败次数
+	OpenedAt            int64  `json:"opened_at,omitempty"`  // 最近一次熔断的时间戳
+}
+
+// Health 服务健康状态
+type Health struct {
+	// Status 所有熔断器都处于关闭状态时为 ok，否则为 degraded
+	Status   string        `json:"status"`
+	Breakers []BreakerStat `json:"breakers"`
 }
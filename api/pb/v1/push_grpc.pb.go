@@ -19,7 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PushService_Push_FullMethodName = "/v1.PushService/Push"
+	PushService_Push_FullMethodName                = "/v1.PushService/Push"
+	PushService_MultiPush_FullMethodName           = "/v1.PushService/MultiPush"
+	PushService_ListInvalidTokens_FullMethodName   = "/v1.PushService/ListInvalidTokens"
+	PushService_Subscribe_FullMethodName           = "/v1.PushService/Subscribe"
+	PushService_Unsubscribe_FullMethodName         = "/v1.PushService/Unsubscribe"
+	PushService_PushToTopic_FullMethodName         = "/v1.PushService/PushToTopic"
+	PushService_ListTopics_FullMethodName          = "/v1.PushService/ListTopics"
+	PushService_CheckDevice_FullMethodName         = "/v1.PushService/CheckDevice"
+	PushService_ListScheduledPushes_FullMethodName = "/v1.PushService/ListScheduledPushes"
+	PushService_CancelScheduledPush_FullMethodName = "/v1.PushService/CancelScheduledPush"
+	PushService_CreateCronJob_FullMethodName       = "/v1.PushService/CreateCronJob"
+	PushService_GetCronJob_FullMethodName          = "/v1.PushService/GetCronJob"
+	PushService_ListCronJobs_FullMethodName        = "/v1.PushService/ListCronJobs"
+	PushService_DeleteCronJob_FullMethodName       = "/v1.PushService/DeleteCronJob"
+	PushService_GetJob_FullMethodName              = "/v1.PushService/GetJob"
+	PushService_RegisterDevice_FullMethodName      = "/v1.PushService/RegisterDevice"
+	PushService_UnregisterDevice_FullMethodName    = "/v1.PushService/UnregisterDevice"
+	PushService_RefreshDevice_FullMethodName       = "/v1.PushService/RefreshDevice"
+	PushService_ListDevices_FullMethodName         = "/v1.PushService/ListDevices"
+	PushService_TagDevice_FullMethodName           = "/v1.PushService/TagDevice"
+	PushService_PreviewSegment_FullMethodName      = "/v1.PushService/PreviewSegment"
+	PushService_SaveTemplate_FullMethodName        = "/v1.PushService/SaveTemplate"
+	PushService_GetTemplate_FullMethodName         = "/v1.PushService/GetTemplate"
+	PushService_ListTemplates_FullMethodName       = "/v1.PushService/ListTemplates"
+	PushService_DeleteTemplate_FullMethodName      = "/v1.PushService/DeleteTemplate"
+	PushService_GetExperiment_FullMethodName       = "/v1.PushService/GetExperiment"
+	PushService_ListExperiments_FullMethodName     = "/v1.PushService/ListExperiments"
+	PushService_ReportClick_FullMethodName         = "/v1.PushService/ReportClick"
+	PushService_CreateCampaign_FullMethodName      = "/v1.PushService/CreateCampaign"
+	PushService_GetCampaign_FullMethodName         = "/v1.PushService/GetCampaign"
+	PushService_ListCampaigns_FullMethodName       = "/v1.PushService/ListCampaigns"
+	PushService_StartCampaign_FullMethodName       = "/v1.PushService/StartCampaign"
+	PushService_PauseCampaign_FullMethodName       = "/v1.PushService/PauseCampaign"
+	PushService_ResumeCampaign_FullMethodName      = "/v1.PushService/ResumeCampaign"
+	PushService_CancelCampaign_FullMethodName      = "/v1.PushService/CancelCampaign"
 )
 
 // PushServiceClient is the client API for PushService service.
@@ -27,6 +61,40 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PushServiceClient interface {
 	Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	MultiPush(ctx context.Context, in *MultiPushRequest, opts ...grpc.CallOption) (*MultiPushResponse, error)
+	ListInvalidTokens(ctx context.Context, in *ListInvalidTokensRequest, opts ...grpc.CallOption) (*ListInvalidTokensResponse, error)
+	Subscribe(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	Unsubscribe(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	PushToTopic(ctx context.Context, in *TopicPushRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
+	CheckDevice(ctx context.Context, in *CheckDeviceRequest, opts ...grpc.CallOption) (*CheckDeviceResponse, error)
+	ListScheduledPushes(ctx context.Context, in *ListScheduledPushesRequest, opts ...grpc.CallOption) (*ListScheduledPushesResponse, error)
+	CancelScheduledPush(ctx context.Context, in *CancelScheduledPushRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	CreateCronJob(ctx context.Context, in *CreateCronJobRequest, opts ...grpc.CallOption) (*CronJob, error)
+	GetCronJob(ctx context.Context, in *GetCronJobRequest, opts ...grpc.CallOption) (*CronJob, error)
+	ListCronJobs(ctx context.Context, in *ListCronJobsRequest, opts ...grpc.CallOption) (*ListCronJobsResponse, error)
+	DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*PushJob, error)
+	RegisterDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*Device, error)
+	UnregisterDevice(ctx context.Context, in *UnregisterDeviceRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	RefreshDevice(ctx context.Context, in *RefreshDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	TagDevice(ctx context.Context, in *TagDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	PreviewSegment(ctx context.Context, in *PreviewSegmentRequest, opts ...grpc.CallOption) (*PreviewSegmentResponse, error)
+	SaveTemplate(ctx context.Context, in *MessageTemplate, opts ...grpc.CallOption) (*MessageTemplate, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*MessageTemplate, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*Experiment, error)
+	ListExperiments(ctx context.Context, in *ListExperimentsRequest, opts ...grpc.CallOption) (*ListExperimentsResponse, error)
+	ReportClick(ctx context.Context, in *ReportClickRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	GetCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error)
+	StartCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	PauseCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	ResumeCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	CancelCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
 }
 
 type pushServiceClient struct {
@@ -46,11 +114,351 @@ func (c *pushServiceClient) Push(ctx context.Context, in *PushRequest, opts ...g
 	return out, nil
 }
 
+func (c *pushServiceClient) MultiPush(ctx context.Context, in *MultiPushRequest, opts ...grpc.CallOption) (*MultiPushResponse, error) {
+	out := new(MultiPushResponse)
+	err := c.cc.Invoke(ctx, PushService_MultiPush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListInvalidTokens(ctx context.Context, in *ListInvalidTokensRequest, opts ...grpc.CallOption) (*ListInvalidTokensResponse, error) {
+	out := new(ListInvalidTokensResponse)
+	err := c.cc.Invoke(ctx, PushService_ListInvalidTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) Subscribe(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_Subscribe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) Unsubscribe(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_Unsubscribe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) PushToTopic(ctx context.Context, in *TopicPushRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_PushToTopic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
+	out := new(ListTopicsResponse)
+	err := c.cc.Invoke(ctx, PushService_ListTopics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) CheckDevice(ctx context.Context, in *CheckDeviceRequest, opts ...grpc.CallOption) (*CheckDeviceResponse, error) {
+	out := new(CheckDeviceResponse)
+	err := c.cc.Invoke(ctx, PushService_CheckDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListScheduledPushes(ctx context.Context, in *ListScheduledPushesRequest, opts ...grpc.CallOption) (*ListScheduledPushesResponse, error) {
+	out := new(ListScheduledPushesResponse)
+	err := c.cc.Invoke(ctx, PushService_ListScheduledPushes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) CancelScheduledPush(ctx context.Context, in *CancelScheduledPushRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_CancelScheduledPush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) CreateCronJob(ctx context.Context, in *CreateCronJobRequest, opts ...grpc.CallOption) (*CronJob, error) {
+	out := new(CronJob)
+	err := c.cc.Invoke(ctx, PushService_CreateCronJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetCronJob(ctx context.Context, in *GetCronJobRequest, opts ...grpc.CallOption) (*CronJob, error) {
+	out := new(CronJob)
+	err := c.cc.Invoke(ctx, PushService_GetCronJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListCronJobs(ctx context.Context, in *ListCronJobsRequest, opts ...grpc.CallOption) (*ListCronJobsResponse, error) {
+	out := new(ListCronJobsResponse)
+	err := c.cc.Invoke(ctx, PushService_ListCronJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_DeleteCronJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*PushJob, error) {
+	out := new(PushJob)
+	err := c.cc.Invoke(ctx, PushService_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) RegisterDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, PushService_RegisterDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) UnregisterDevice(ctx context.Context, in *UnregisterDeviceRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_UnregisterDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) RefreshDevice(ctx context.Context, in *RefreshDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, PushService_RefreshDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, PushService_ListDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) TagDevice(ctx context.Context, in *TagDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, PushService_TagDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) PreviewSegment(ctx context.Context, in *PreviewSegmentRequest, opts ...grpc.CallOption) (*PreviewSegmentResponse, error) {
+	out := new(PreviewSegmentResponse)
+	err := c.cc.Invoke(ctx, PushService_PreviewSegment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) SaveTemplate(ctx context.Context, in *MessageTemplate, opts ...grpc.CallOption) (*MessageTemplate, error) {
+	out := new(MessageTemplate)
+	err := c.cc.Invoke(ctx, PushService_SaveTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*MessageTemplate, error) {
+	out := new(MessageTemplate)
+	err := c.cc.Invoke(ctx, PushService_GetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, PushService_ListTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_DeleteTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*Experiment, error) {
+	out := new(Experiment)
+	err := c.cc.Invoke(ctx, PushService_GetExperiment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListExperiments(ctx context.Context, in *ListExperimentsRequest, opts ...grpc.CallOption) (*ListExperimentsResponse, error) {
+	out := new(ListExperimentsResponse)
+	err := c.cc.Invoke(ctx, PushService_ListExperiments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ReportClick(ctx context.Context, in *ReportClickRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, PushService_ReportClick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, PushService_CreateCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, PushService_GetCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error) {
+	out := new(ListCampaignsResponse)
+	err := c.cc.Invoke(ctx, PushService_ListCampaigns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) StartCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, PushService_StartCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) PauseCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, PushService_PauseCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ResumeCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, PushService_ResumeCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) CancelCampaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, PushService_CancelCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushServiceServer is the server API for PushService service.
 // All implementations should embed UnimplementedPushServiceServer
 // for forward compatibility
 type PushServiceServer interface {
 	Push(context.Context, *PushRequest) (*PushResponse, error)
+	MultiPush(context.Context, *MultiPushRequest) (*MultiPushResponse, error)
+	ListInvalidTokens(context.Context, *ListInvalidTokensRequest) (*ListInvalidTokensResponse, error)
+	Subscribe(context.Context, *TopicRequest) (*PushResponse, error)
+	Unsubscribe(context.Context, *TopicRequest) (*PushResponse, error)
+	PushToTopic(context.Context, *TopicPushRequest) (*PushResponse, error)
+	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
+	CheckDevice(context.Context, *CheckDeviceRequest) (*CheckDeviceResponse, error)
+	ListScheduledPushes(context.Context, *ListScheduledPushesRequest) (*ListScheduledPushesResponse, error)
+	CancelScheduledPush(context.Context, *CancelScheduledPushRequest) (*PushResponse, error)
+	CreateCronJob(context.Context, *CreateCronJobRequest) (*CronJob, error)
+	GetCronJob(context.Context, *GetCronJobRequest) (*CronJob, error)
+	ListCronJobs(context.Context, *ListCronJobsRequest) (*ListCronJobsResponse, error)
+	DeleteCronJob(context.Context, *DeleteCronJobRequest) (*PushResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*PushJob, error)
+	RegisterDevice(context.Context, *Device) (*Device, error)
+	UnregisterDevice(context.Context, *UnregisterDeviceRequest) (*PushResponse, error)
+	RefreshDevice(context.Context, *RefreshDeviceRequest) (*Device, error)
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	TagDevice(context.Context, *TagDeviceRequest) (*Device, error)
+	PreviewSegment(context.Context, *PreviewSegmentRequest) (*PreviewSegmentResponse, error)
+	SaveTemplate(context.Context, *MessageTemplate) (*MessageTemplate, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*MessageTemplate, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*PushResponse, error)
+	GetExperiment(context.Context, *GetExperimentRequest) (*Experiment, error)
+	ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error)
+	ReportClick(context.Context, *ReportClickRequest) (*PushResponse, error)
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*Campaign, error)
+	GetCampaign(context.Context, *CampaignRequest) (*Campaign, error)
+	ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error)
+	StartCampaign(context.Context, *CampaignRequest) (*Campaign, error)
+	PauseCampaign(context.Context, *CampaignRequest) (*Campaign, error)
+	ResumeCampaign(context.Context, *CampaignRequest) (*Campaign, error)
+	CancelCampaign(context.Context, *CampaignRequest) (*Campaign, error)
 }
 
 // UnimplementedPushServiceServer should be embedded to have forward compatible implementations.
@@ -60,6 +468,108 @@ type UnimplementedPushServiceServer struct {
 func (UnimplementedPushServiceServer) Push(context.Context, *PushRequest) (*PushResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Push not implemented")
 }
+func (UnimplementedPushServiceServer) MultiPush(context.Context, *MultiPushRequest) (*MultiPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiPush not implemented")
+}
+func (UnimplementedPushServiceServer) ListInvalidTokens(context.Context, *ListInvalidTokensRequest) (*ListInvalidTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvalidTokens not implemented")
+}
+func (UnimplementedPushServiceServer) Subscribe(context.Context, *TopicRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedPushServiceServer) Unsubscribe(context.Context, *TopicRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedPushServiceServer) PushToTopic(context.Context, *TopicPushRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushToTopic not implemented")
+}
+func (UnimplementedPushServiceServer) ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
+func (UnimplementedPushServiceServer) CheckDevice(context.Context, *CheckDeviceRequest) (*CheckDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDevice not implemented")
+}
+func (UnimplementedPushServiceServer) ListScheduledPushes(context.Context, *ListScheduledPushesRequest) (*ListScheduledPushesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledPushes not implemented")
+}
+func (UnimplementedPushServiceServer) CancelScheduledPush(context.Context, *CancelScheduledPushRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPush not implemented")
+}
+func (UnimplementedPushServiceServer) CreateCronJob(context.Context, *CreateCronJobRequest) (*CronJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCronJob not implemented")
+}
+func (UnimplementedPushServiceServer) GetCronJob(context.Context, *GetCronJobRequest) (*CronJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCronJob not implemented")
+}
+func (UnimplementedPushServiceServer) ListCronJobs(context.Context, *ListCronJobsRequest) (*ListCronJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCronJobs not implemented")
+}
+func (UnimplementedPushServiceServer) DeleteCronJob(context.Context, *DeleteCronJobRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronJob not implemented")
+}
+func (UnimplementedPushServiceServer) GetJob(context.Context, *GetJobRequest) (*PushJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedPushServiceServer) RegisterDevice(context.Context, *Device) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDevice not implemented")
+}
+func (UnimplementedPushServiceServer) UnregisterDevice(context.Context, *UnregisterDeviceRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDevice not implemented")
+}
+func (UnimplementedPushServiceServer) RefreshDevice(context.Context, *RefreshDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshDevice not implemented")
+}
+func (UnimplementedPushServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedPushServiceServer) TagDevice(context.Context, *TagDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagDevice not implemented")
+}
+func (UnimplementedPushServiceServer) PreviewSegment(context.Context, *PreviewSegmentRequest) (*PreviewSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSegment not implemented")
+}
+func (UnimplementedPushServiceServer) SaveTemplate(context.Context, *MessageTemplate) (*MessageTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveTemplate not implemented")
+}
+func (UnimplementedPushServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*MessageTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedPushServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedPushServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedPushServiceServer) GetExperiment(context.Context, *GetExperimentRequest) (*Experiment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperiment not implemented")
+}
+func (UnimplementedPushServiceServer) ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperiments not implemented")
+}
+func (UnimplementedPushServiceServer) ReportClick(context.Context, *ReportClickRequest) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportClick not implemented")
+}
+func (UnimplementedPushServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*Campaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedPushServiceServer) GetCampaign(context.Context, *CampaignRequest) (*Campaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedPushServiceServer) ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedPushServiceServer) StartCampaign(context.Context, *CampaignRequest) (*Campaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCampaign not implemented")
+}
+func (UnimplementedPushServiceServer) PauseCampaign(context.Context, *CampaignRequest) (*Campaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCampaign not implemented")
+}
+func (UnimplementedPushServiceServer) ResumeCampaign(context.Context, *CampaignRequest) (*Campaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCampaign not implemented")
+}
+func (UnimplementedPushServiceServer) CancelCampaign(context.Context, *CampaignRequest) (*Campaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCampaign not implemented")
+}
 
 // UnsafePushServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PushServiceServer will
@@ -90,16 +600,764 @@ func _PushService_Push_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-// PushService_ServiceDesc is the grpc.ServiceDesc for PushService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PushService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "v1.PushService",
-	HandlerType: (*PushServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Push",
-			Handler:    _PushService_Push_Handler,
+func _PushService_MultiPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).MultiPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_MultiPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).MultiPush(ctx, req.(*MultiPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListInvalidTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvalidTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListInvalidTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListInvalidTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListInvalidTokens(ctx, req.(*ListInvalidTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).Subscribe(ctx, req.(*TopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).Unsubscribe(ctx, req.(*TopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_PushToTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).PushToTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_PushToTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).PushToTopic(ctx, req.(*TopicPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListTopics(ctx, req.(*ListTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_CheckDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).CheckDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_CheckDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).CheckDevice(ctx, req.(*CheckDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListScheduledPushes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledPushesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListScheduledPushes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListScheduledPushes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListScheduledPushes(ctx, req.(*ListScheduledPushesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_CancelScheduledPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).CancelScheduledPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_CancelScheduledPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).CancelScheduledPush(ctx, req.(*CancelScheduledPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_CreateCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).CreateCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_CreateCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).CreateCronJob(ctx, req.(*CreateCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetCronJob(ctx, req.(*GetCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListCronJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCronJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListCronJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListCronJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListCronJobs(ctx, req.(*ListCronJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_DeleteCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).DeleteCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_DeleteCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).DeleteCronJob(ctx, req.(*DeleteCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_RegisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Device)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).RegisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_RegisterDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).RegisterDevice(ctx, req.(*Device))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_UnregisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).UnregisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_UnregisterDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).UnregisterDevice(ctx, req.(*UnregisterDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_RefreshDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).RefreshDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_RefreshDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).RefreshDevice(ctx, req.(*RefreshDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_TagDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).TagDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_TagDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).TagDevice(ctx, req.(*TagDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_PreviewSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).PreviewSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_PreviewSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).PreviewSegment(ctx, req.(*PreviewSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_SaveTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).SaveTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_SaveTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).SaveTemplate(ctx, req.(*MessageTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetExperiment(ctx, req.(*GetExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListExperiments(ctx, req.(*ListExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ReportClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ReportClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ReportClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ReportClick(ctx, req.(*ReportClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetCampaign(ctx, req.(*CampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListCampaigns(ctx, req.(*ListCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_StartCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).StartCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_StartCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).StartCampaign(ctx, req.(*CampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_PauseCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).PauseCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_PauseCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).PauseCampaign(ctx, req.(*CampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ResumeCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ResumeCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ResumeCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ResumeCampaign(ctx, req.(*CampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_CancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).CancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_CancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).CancelCampaign(ctx, req.(*CampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PushService_ServiceDesc is the grpc.ServiceDesc for PushService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PushService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.PushService",
+	HandlerType: (*PushServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Push",
+			Handler:    _PushService_Push_Handler,
+		},
+		{
+			MethodName: "MultiPush",
+			Handler:    _PushService_MultiPush_Handler,
+		},
+		{
+			MethodName: "ListInvalidTokens",
+			Handler:    _PushService_ListInvalidTokens_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _PushService_Subscribe_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _PushService_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "PushToTopic",
+			Handler:    _PushService_PushToTopic_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _PushService_ListTopics_Handler,
+		},
+		{
+			MethodName: "CheckDevice",
+			Handler:    _PushService_CheckDevice_Handler,
+		},
+		{
+			MethodName: "ListScheduledPushes",
+			Handler:    _PushService_ListScheduledPushes_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPush",
+			Handler:    _PushService_CancelScheduledPush_Handler,
+		},
+		{
+			MethodName: "CreateCronJob",
+			Handler:    _PushService_CreateCronJob_Handler,
+		},
+		{
+			MethodName: "GetCronJob",
+			Handler:    _PushService_GetCronJob_Handler,
+		},
+		{
+			MethodName: "ListCronJobs",
+			Handler:    _PushService_ListCronJobs_Handler,
+		},
+		{
+			MethodName: "DeleteCronJob",
+			Handler:    _PushService_DeleteCronJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _PushService_GetJob_Handler,
+		},
+		{
+			MethodName: "RegisterDevice",
+			Handler:    _PushService_RegisterDevice_Handler,
+		},
+		{
+			MethodName: "UnregisterDevice",
+			Handler:    _PushService_UnregisterDevice_Handler,
+		},
+		{
+			MethodName: "RefreshDevice",
+			Handler:    _PushService_RefreshDevice_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _PushService_ListDevices_Handler,
+		},
+		{
+			MethodName: "TagDevice",
+			Handler:    _PushService_TagDevice_Handler,
+		},
+		{
+			MethodName: "PreviewSegment",
+			Handler:    _PushService_PreviewSegment_Handler,
+		},
+		{
+			MethodName: "SaveTemplate",
+			Handler:    _PushService_SaveTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _PushService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _PushService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _PushService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "GetExperiment",
+			Handler:    _PushService_GetExperiment_Handler,
+		},
+		{
+			MethodName: "ListExperiments",
+			Handler:    _PushService_ListExperiments_Handler,
+		},
+		{
+			MethodName: "ReportClick",
+			Handler:    _PushService_ReportClick_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _PushService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _PushService_GetCampaign_Handler,
+		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _PushService_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "StartCampaign",
+			Handler:    _PushService_StartCampaign_Handler,
+		},
+		{
+			MethodName: "PauseCampaign",
+			Handler:    _PushService_PauseCampaign_Handler,
+		},
+		{
+			MethodName: "ResumeCampaign",
+			Handler:    _PushService_ResumeCampaign_Handler,
+		},
+		{
+			MethodName: "CancelCampaign",
+			Handler:    _PushService_CancelCampaign_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2,9 +2,19 @@ package push
 
 import (
 	"context"
+	"errors"
 	v1 "github.com/cossim/hipush/api/pb/v1"
 )
 
+var (
+	// ErrUnsupported 推送服务不支持该操作，调用方可以通过 errors.Is 判断
+	ErrUnsupported = errors.New("operation not supported by the push service")
+	// ErrQuotaExceeded 平台或应用当天的推送配额已用完，调用方可以通过 errors.Is 判断
+	ErrQuotaExceeded = errors.New("daily push quota exceeded")
+	// ErrCircuitOpen 厂商接口的熔断器处于打开状态，请求被快速失败，调用方可以通过 errors.Is 判断
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+)
+
 type TaskObjectList interface {
 	Add(obj TaskObject)
 	Get() []TaskObject
@@ -33,6 +43,73 @@ type TaskObject interface {
 
 type SendResponse struct {
 	TaskId string `json:"task_id"`
+	// TaskIDs 批量推送时每个分片对应的任务id
+	TaskIDs []string `json:"task_ids,omitempty"`
+	// Results 每个设备的推送结果
+	Results []TokenResult `json:"results,omitempty"`
+	// Deferred 不在投递时间窗口内、推迟到窗口开始时推送的设备数量
+	Deferred int `json:"deferred,omitempty"`
+}
+
+// SuccessCount 推送成功的设备数量
+func (r *SendResponse) SuccessCount() int {
+	if r == nil {
+		return 0
+	}
+	var n int
+	for _, v := range r.Results {
+		if v.Success {
+			n++
+		}
+	}
+	return n
+}
+
+// FailureCount 推送失败的设备数量，不包括超过推送频率上限的设备
+func (r *SendResponse) FailureCount() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.Results) - r.SuccessCount() - r.CappedCount()
+}
+
+// CappedCount 超过推送频率上限未推送的设备数量
+func (r *SendResponse) CappedCount() int {
+	if r == nil {
+		return 0
+	}
+	var n int
+	for _, v := range r.Results {
+		if v.Capped {
+			n++
+		}
+	}
+	return n
+}
+
+// PartialSuccess 是否只有部分设备推送成功
+func (r *SendResponse) PartialSuccess() bool {
+	return r.SuccessCount() > 0 && r.FailureCount() > 0
+}
+
+// TokenResult 单个设备的推送结果
+type TokenResult struct {
+	// Token 设备标识
+	Token string `json:"token"`
+	// Success 是否推送成功
+	Success bool `json:"success"`
+	// Code 厂商返回的状态码
+	Code string `json:"code,omitempty"`
+	// Msg 厂商返回的消息
+	Msg string `json:"msg,omitempty"`
+	// MessageID 厂商返回的消息id
+	MessageID string `json:"message_id,omitempty"`
+	// Attempts 实际发送次数
+	Attempts int `json:"attempts"`
+	// InvalidReason 厂商反馈 token 已失效时的失效原因，为空表示 token 有效或未知
+	InvalidReason string `json:"invalid_reason,omitempty"`
+	// Capped 设备超过推送频率上限，未推送
+	Capped bool `json:"capped,omitempty"`
 }
 
 type Message interface {
@@ -66,14 +143,86 @@ type SendRequest interface {
 	Message
 }
 
+// TopicRequest 订阅或取消订阅主题的请求，GetToken 返回需要订阅或取消订阅的设备
+type TopicRequest interface {
+	Meta
+	GetTopic() string
+}
+
+// ListTopicsRequest 查询设备已订阅主题的请求
+type ListTopicsRequest interface {
+	GetAppID() string
+	GetAppName() string
+	GetToken() string
+}
+
+// TopicResponse 订阅或取消订阅主题的结果
+type TopicResponse struct {
+	// Results 每个设备的订阅结果
+	Results []TokenResult `json:"results,omitempty"`
+}
+
+// CheckDeviceRequest 检查设备的请求，GetToken 返回需要检查的设备
+type CheckDeviceRequest interface {
+	Meta
+}
+
+// 设备状态
+const (
+	DeviceValid   = "valid"   // 厂商确认 token 可用
+	DeviceInvalid = "invalid" // token 格式错误或厂商反馈 token 已失效
+	DeviceUnknown = "unknown" // token 格式正确，但厂商不支持校验或校验失败
+)
+
+// CheckDeviceResponse 检查设备的结果
+type CheckDeviceResponse struct {
+	// Results 每个设备的检查结果，与请求中的 token 顺序一致
+	Results []DeviceResult `json:"results"`
+}
+
+// DeviceResult 单个设备的检查结果
+type DeviceResult struct {
+	// Token 设备标识
+	Token string `json:"token"`
+	// Status 设备状态 DeviceValid、DeviceInvalid 或 DeviceUnknown
+	Status string `json:"status"`
+	// Reason 设备不可用或状态未知的原因
+	Reason string `json:"reason,omitempty"`
+	// Environment APNs token 所属的环境 production 或 development
+	Environment string `json:"environment,omitempty"`
+}
+
 // PushService 提供推送服务的接口
 type PushService interface {
 	// Send 发送消息给单个设备
 	Send(ctx context.Context, req SendRequest, opt ...SendOption) (*SendResponse, error)
 
+	// Multicast 使用厂商原生的批量接口发送消息给多个设备，并按厂商单次调用的设备上限自动分片
+	Multicast(ctx context.Context, req SendRequest, opt ...MulticastOption) (*SendResponse, error)
+
 	// GetTasksStatus 查询推送消息的统计信息
 	GetTasksStatus(ctx context.Context, appid string, taskID []string, obj TaskObjectList) error
 
+	// CheckDevice 检查设备 token 是否可用，厂商支持时使用只校验不推送的方式验证 token
+	CheckDevice(ctx context.Context, req CheckDeviceRequest, opt ...CheckDeviceOption) (*CheckDeviceResponse, error)
+
 	// Name 获取推送的手机厂商名称
 	Name() string
+
+	TopicManager
+}
+
+// TopicManager 提供主题（标签）订阅管理的接口，厂商不支持的操作返回 ErrUnsupported
+type TopicManager interface {
+	// Subscribe 为设备订阅主题
+	Subscribe(ctx context.Context, req TopicRequest, opt ...SubscribeOption) (*TopicResponse, error)
+
+	// Unsubscribe 为设备取消订阅主题
+	Unsubscribe(ctx context.Context, req TopicRequest, opt ...UnsubscribeOption) (*TopicResponse, error)
+
+	// SendToTopic 发送消息给订阅了主题的所有设备
+	SendToTopic(ctx context.Context, topic string, req SendRequest, opt ...TopicOption) (*SendResponse, error)
+
+	// ListTopics 查询设备已订阅的主题
+	ListTopics(ctx context.Context, req ListTopicsRequest) ([]string, error)
 }
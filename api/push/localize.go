@@ -0,0 +1,39 @@
+package push
+
+// LocalizedContent 指定语言的推送标题及内容，为空的字段使用推送请求的默认标题或内容
+type LocalizedContent struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// LocalizedRequest 携带多语言推送内容的推送请求，推送服务按设备的语言选择最匹配的标题及内容，
+// 没有匹配的语言时使用推送请求的默认标题及内容
+type LocalizedRequest interface {
+	SendRequest
+	// GetLocalized 各语言的推送内容，key 为语言标签，例如 zh-TW、en
+	GetLocalized() map[string]LocalizedContent
+	// GetTokenLocales 设备的语言，key 为 token
+	GetTokenLocales() map[string]string
+}
+
+// WithLocalization 为推送请求附加多语言推送内容及设备的语言，localized 为空时直接返回 req
+func WithLocalization(req SendRequest, localized map[string]LocalizedContent, tokenLocales map[string]string) SendRequest {
+	if len(localized) == 0 {
+		return req
+	}
+	return &localizedRequest{SendRequest: req, localized: localized, tokenLocales: tokenLocales}
+}
+
+type localizedRequest struct {
+	SendRequest
+	localized    map[string]LocalizedContent
+	tokenLocales map[string]string
+}
+
+func (r *localizedRequest) GetLocalized() map[string]LocalizedContent {
+	return r.localized
+}
+
+func (r *localizedRequest) GetTokenLocales() map[string]string {
+	return r.tokenLocales
+}
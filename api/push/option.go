@@ -40,12 +40,48 @@ type MulticastOption interface {
 
 // MulticastOptions 用于设置发送多个消息选项的结构体
 type MulticastOptions struct {
-	// MaxDevices 最大设备数
+	// DryRun 只进行数据校验不实际推送，数据校验成功即为成功
+	DryRun bool `json:"dry_run,omitempty"`
+	// Development 测试环境推送
+	Development bool `json:"development,omitempty"`
+	// Retry 每个分片的重试次数
+	Retry int32 `json:"retry,omitempty"`
+	// RetryInterval 重试间隔（以秒为单位）
+	RetryInterval int32 `json:"retry_interval"`
+	// MaxDevices 单次调用厂商接口的最大设备数，为 0 或超过厂商上限时使用厂商上限
 	MaxDevices int `json:"max_devices,omitempty"`
-	// Delay 两次发送之间的延迟时间
+	// Delay 两次发送之间的延迟时间（以毫秒为单位）
 	Delay int `json:"delay,omitempty"`
 }
 
+func (m *MulticastOptions) Apply(option *MulticastOptions) {
+	if m.DryRun {
+		option.DryRun = true
+	}
+	if m.Development {
+		option.Development = true
+	}
+	option.Retry = m.Retry
+	option.RetryInterval = m.RetryInterval
+	option.MaxDevices = m.MaxDevices
+	option.Delay = m.Delay
+}
+
+func (m *MulticastOptions) ApplyOptions(opts []MulticastOption) *MulticastOptions {
+	for _, opt := range opts {
+		opt.Apply(m)
+	}
+	return m
+}
+
+// BatchSize 返回每个分片的设备数，limit 为厂商单次调用的上限
+func (m *MulticastOptions) BatchSize(limit int) int {
+	if m.MaxDevices <= 0 || m.MaxDevices > limit {
+		return limit
+	}
+	return m.MaxDevices
+}
+
 type SubscribeOption interface {
 	Apply(option *SubscribeOptions)
 }
@@ -55,7 +91,7 @@ type SubscribeOptions struct {
 }
 
 type UnsubscribeOption interface {
-	Apply(option *UnsubscribeOption)
+	Apply(option *UnsubscribeOptions)
 }
 
 // UnsubscribeOptions 用于设置取消订阅选项的结构体
@@ -68,6 +104,32 @@ type TopicOption interface {
 
 // TopicOptions 用于设置发送到特定主题选项的结构体
 type TopicOptions struct {
+	// DryRun 只进行数据校验不实际推送，数据校验成功即为成功
+	DryRun bool `json:"dry_run,omitempty"`
+	// Development 测试环境推送
+	Development bool `json:"development,omitempty"`
+	// Retry 重试次数
+	Retry int32 `json:"retry,omitempty"`
+	// RetryInterval 重试间隔（以秒为单位）
+	RetryInterval int32 `json:"retry_interval"`
+}
+
+func (t *TopicOptions) Apply(option *TopicOptions) {
+	if t.DryRun {
+		option.DryRun = true
+	}
+	if t.Development {
+		option.Development = true
+	}
+	option.Retry = t.Retry
+	option.RetryInterval = t.RetryInterval
+}
+
+func (t *TopicOptions) ApplyOptions(opts []TopicOption) *TopicOptions {
+	for _, opt := range opts {
+		opt.Apply(t)
+	}
+	return t
 }
 
 type CheckDeviceOption interface {
@@ -76,6 +138,17 @@ type CheckDeviceOption interface {
 
 // CheckDeviceOptions 用于设置检查设备是否可用选项的结构体
 type CheckDeviceOptions struct {
-	// Timeout 检查设备的超时时间
+	// Timeout 检查设备的超时时间（以秒为单位），为 0 时不限制
 	Timeout int `json:"timeout,omitempty"`
 }
+
+func (c *CheckDeviceOptions) Apply(option *CheckDeviceOptions) {
+	option.Timeout = c.Timeout
+}
+
+func (c *CheckDeviceOptions) ApplyOptions(opts []CheckDeviceOption) *CheckDeviceOptions {
+	for _, opt := range opts {
+		opt.Apply(c)
+	}
+	return c
+}
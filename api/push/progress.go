@@ -0,0 +1,24 @@
+package push
+
+import "context"
+
+// ProgressFunc 每个设备推送结束后的回调，可能被并发调用
+type ProgressFunc func(result TokenResult)
+
+type progressKey struct{}
+
+// WithProgress 返回携带推送进度回调的 context，fn 为 nil 时不再回调
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// ReportProgress 将设备的推送结果回调给 context 中的 ProgressFunc
+func ReportProgress(ctx context.Context, results ...TokenResult) {
+	fn, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	if fn == nil {
+		return
+	}
+	for _, r := range results {
+		fn(r)
+	}
+}
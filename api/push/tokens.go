@@ -0,0 +1,19 @@
+package push
+
+// WithTokens 返回只推送到 tokens 的推送请求，保留 req 携带的多语言推送内容
+func WithTokens(req SendRequest, tokens []string) SendRequest {
+	r := &tokensRequest{SendRequest: req, tokens: tokens}
+	if lr, ok := req.(LocalizedRequest); ok {
+		return WithLocalization(r, lr.GetLocalized(), lr.GetTokenLocales())
+	}
+	return r
+}
+
+type tokensRequest struct {
+	SendRequest
+	tokens []string
+}
+
+func (r *tokensRequest) GetToken() []string {
+	return r.tokens
+}
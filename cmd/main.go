@@ -4,10 +4,23 @@ import (
 	"context"
 	"flag"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/campaign"
+	"github.com/cossim/hipush/internal/dispatcher"
 	"github.com/cossim/hipush/internal/factory"
+	"github.com/cossim/hipush/internal/job"
+	"github.com/cossim/hipush/internal/lane"
+	"github.com/cossim/hipush/internal/scheduler"
 	g "github.com/cossim/hipush/internal/server/grpc"
 	h "github.com/cossim/hipush/internal/server/http"
+	"github.com/cossim/hipush/internal/template"
+	"github.com/cossim/hipush/pkg/breaker"
+	"github.com/cossim/hipush/pkg/device"
+	"github.com/cossim/hipush/pkg/experiment"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/frequency"
+	"github.com/cossim/hipush/pkg/idempotency"
 	"github.com/cossim/hipush/pkg/push"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
@@ -41,12 +54,37 @@ func main() {
 		panic(err)
 	}
 
+	if err := feedback.InitInvalidTokenStorage(cfg); err != nil {
+		panic(err)
+	}
+
+	if err := device.InitPushDevices(cfg); err != nil {
+		panic(err)
+	}
+
+	if err := template.InitPushTemplates(cfg); err != nil {
+		panic(err)
+	}
+
+	if err := idempotency.InitPushKeys(cfg); err != nil {
+		panic(err)
+	}
+
+	if err := frequency.InitPushCapper(cfg); err != nil {
+		panic(err)
+	}
+
+	if err := experiment.InitPushExperiments(cfg); err != nil {
+		panic(err)
+	}
+
+	if err := ratelimit.InitPushLimiter(cfg); err != nil {
+		panic(err)
+	}
+	breaker.InitPushBreakers(cfg)
+
 	zapLogger := zap.NewExample()
 	logger := zapr.NewLogger(zapLogger)
-	//scheduler, err := gocron.NewScheduler()
-	//if err != nil {
-	//	panic(err)
-	//}
 
 	pushServiceFactory := factory.NewPushServiceFactory()
 	if err := pushServiceFactory.Register(
@@ -62,6 +100,27 @@ func main() {
 		panic(err)
 	}
 
+	lane.InitPushLanes(cfg)
+	dispatcher.InitPushApps(cfg)
+	pushDispatcher := dispatcher.New(pushServiceFactory)
+	if err := scheduler.InitPushScheduler(cfg, pushDispatcher, logger); err != nil {
+		panic(err)
+	}
+	dispatcher.PushDeferrer = scheduler.PushScheduler
+	// 定时推送调度器启动时会将已到时间的均匀推送请求提交到异步推送任务，需要在其初始化后启动
+	if err := job.InitPushJobs(cfg, pushDispatcher, logger); err != nil {
+		panic(err)
+	}
+	if err := scheduler.PushScheduler.Start(); err != nil {
+		panic(err)
+	}
+	if err := scheduler.InitPushCronScheduler(cfg, pushDispatcher, logger); err != nil {
+		panic(err)
+	}
+	if err := campaign.InitPushCampaigns(cfg, pushDispatcher, logger); err != nil {
+		panic(err)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -97,7 +156,19 @@ func main() {
 			return
 		case <-sig:
 			log.Println("receive system signal, cancel context")
+			// 先停止产生推送的定时任务、异步任务及推送活动，等待正在进行的推送结束后再关闭其依赖的存储
+			scheduler.PushCronScheduler.Close()
+			scheduler.PushScheduler.Close()
+			job.PushJobs.Close()
+			campaign.PushCampaigns.Close()
 			status.StatStorage.Close()
+			feedback.InvalidTokenStorage.Close()
+			device.PushDevices.Close()
+			template.PushTemplates.Close()
+			idempotency.PushKeys.Close()
+			ratelimit.PushLimiter.Close()
+			frequency.PushCapper.Close()
+			experiment.PushExperiments.Close()
 			cancel()
 		}
 	}()
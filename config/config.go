@@ -7,93 +7,202 @@ import (
 )
 
 type iOSAppConfig struct {
-	MaxConcurrentPushes int    `yaml:"max_concurrent_pushes"`
-	MaxRetry            int    `yaml:"max_retry"`
-	Enabled             bool   `yaml:"enabled"`
-	Production          bool   `yaml:"production"`
-	AppName             string `yaml:"app_name"`
-	AppID               string `yaml:"app_id"`
-	KeyPath             string `yaml:"key_path"`
-	KeyType             string `yaml:"key_type"`
-	Password            string `yaml:"password"`
-	KeyID               string `yaml:"key_id"`
-	TeamID              string `yaml:"team_id"`
+	SendConfig `yaml:",inline"`
+	Enabled    bool            `yaml:"enabled"`
+	Production bool            `yaml:"production"`
+	AppName    string          `yaml:"app_name"`
+	AppID      string          `yaml:"app_id"`
+	KeyPath    string          `yaml:"key_path"`
+	KeyType    string          `yaml:"key_type"`
+	Password   string          `yaml:"password"`
+	KeyID      string          `yaml:"key_id"`
+	TeamID     string          `yaml:"team_id"`
+	RateLimit  RateLimitConfig `yaml:"rate_limit"`
 }
 
 type HuaweiAppConfig struct {
-	Enabled   bool   `yaml:"enabled"`
-	AppName   string `yaml:"app_name"`
-	AppID     string `yaml:"app_id"`
-	AppSecret string `yaml:"app_secret"`
-	AuthUrl   string `yaml:"auth_url"`
-	PushUrl   string `yaml:"push_url"`
+	SendConfig `yaml:",inline"`
+	Enabled    bool            `yaml:"enabled"`
+	AppName    string          `yaml:"app_name"`
+	AppID      string          `yaml:"app_id"`
+	AppSecret  string          `yaml:"app_secret"`
+	AuthUrl    string          `yaml:"auth_url"`
+	PushUrl    string          `yaml:"push_url"`
+	RateLimit  RateLimitConfig `yaml:"rate_limit"`
 }
 
 type VivoAppConfig struct {
-	Enabled   bool   `yaml:"enabled"`
-	AppName   string `yaml:"app_name"`
-	AppID     string `yaml:"app_id"`
-	AppKey    string `yaml:"app_key"`
-	AppSecret string `yaml:"app_secret"`
+	SendConfig `yaml:",inline"`
+	Enabled    bool            `yaml:"enabled"`
+	AppName    string          `yaml:"app_name"`
+	AppID      string          `yaml:"app_id"`
+	AppKey     string          `yaml:"app_key"`
+	AppSecret  string          `yaml:"app_secret"`
+	RateLimit  RateLimitConfig `yaml:"rate_limit"`
 }
 
 type OppoAppConfig struct {
-	Enabled   bool   `yaml:"enabled"`
-	AppName   string `yaml:"app_name"`
-	AppID     string `yaml:"app_id"`
-	AppKey    string `yaml:"app_key"`
-	AppSecret string `yaml:"app_secret"`
+	SendConfig `yaml:",inline"`
+	Enabled    bool            `yaml:"enabled"`
+	AppName    string          `yaml:"app_name"`
+	AppID      string          `yaml:"app_id"`
+	AppKey     string          `yaml:"app_key"`
+	AppSecret  string          `yaml:"app_secret"`
+	RateLimit  RateLimitConfig `yaml:"rate_limit"`
 }
 
 type AndroidAppConfig struct {
-	Enabled bool   `yaml:"enabled"`
-	AppName string `yaml:"app_name"`
-	AppID   string `yaml:"app_id"`
-	AppKey  string `yaml:"app_key"`
-	KeyPath string `yaml:"key_path"`
+	SendConfig `yaml:",inline"`
+	Enabled    bool            `yaml:"enabled"`
+	AppName    string          `yaml:"app_name"`
+	AppID      string          `yaml:"app_id"`
+	AppKey     string          `yaml:"app_key"`
+	KeyPath    string          `yaml:"key_path"`
+	RateLimit  RateLimitConfig `yaml:"rate_limit"`
 }
 
 type XiaomiAppConfig struct {
-	Enabled   bool     `yaml:"enabled"`
-	AppName   string   `yaml:"app_name"`
-	AppID     string   `yaml:"app_id"`
-	AppSecret string   `yaml:"app_secret"`
-	Package   []string `yaml:"package"`
+	SendConfig `yaml:",inline"`
+	Enabled    bool            `yaml:"enabled"`
+	AppName    string          `yaml:"app_name"`
+	AppID      string          `yaml:"app_id"`
+	AppSecret  string          `yaml:"app_secret"`
+	Package    []string        `yaml:"package"`
+	RateLimit  RateLimitConfig `yaml:"rate_limit"`
 }
 
 type MeizuAppConfig struct {
-	Enabled bool   `yaml:"enabled"`
-	AppName string `json:"app_name"`
-	AppID   string `yaml:"app_id"`
-	AppKey  string `yaml:"app_key"`
+	SendConfig `yaml:",inline"`
+	Enabled    bool            `yaml:"enabled"`
+	AppName    string          `json:"app_name"`
+	AppID      string          `yaml:"app_id"`
+	AppKey     string          `yaml:"app_key"`
+	RateLimit  RateLimitConfig `yaml:"rate_limit"`
 }
 
 type HonorAppConfig struct {
-	Enabled      bool   `yaml:"enabled"`
-	AppName      string `yaml:"app_name"`
-	AppID        string `yaml:"app_id"`
-	ClientID     string `yaml:"client_id"`
-	ClientSecret string `yaml:"client_secret"`
+	SendConfig   `yaml:",inline"`
+	Enabled      bool            `yaml:"enabled"`
+	AppName      string          `yaml:"app_name"`
+	AppID        string          `yaml:"app_id"`
+	ClientID     string          `yaml:"client_id"`
+	ClientSecret string          `yaml:"client_secret"`
+	RateLimit    RateLimitConfig `yaml:"rate_limit"`
 }
 
 type Config struct {
-	HTTP    HTTPConfig         `yaml:"http"`
-	GRPC    GRPCConfig         `yaml:"grpc"`
-	Storage Storage            `yaml:"storage"`
-	IOS     []iOSAppConfig     `yaml:"ios"`
-	Huawei  []HuaweiAppConfig  `yaml:"huawei"`
-	Android []AndroidAppConfig `yaml:"android"`
-	Vivo    []VivoAppConfig    `yaml:"vivo"`
-	Oppo    []OppoAppConfig    `yaml:"oppo"`
-	Xiaomi  []XiaomiAppConfig  `yaml:"xiaomi"`
-	Meizu   []MeizuAppConfig   `yaml:"meizu"`
-	Honor   []HonorAppConfig   `yaml:"honor"`
+	HTTP           HTTPConfig                 `yaml:"http"`
+	GRPC           GRPCConfig                 `yaml:"grpc"`
+	Storage        Storage                    `yaml:"storage"`
+	Feedback       FeedbackConfig             `yaml:"feedback"`
+	Async          AsyncConfig                `yaml:"async"`
+	Templates      TemplateConfig             `yaml:"templates"`
+	Idempotency    IdempotencyConfig          `yaml:"idempotency"`
+	Lanes          map[string]LaneConfig      `yaml:"lanes"`
+	RateLimit      map[string]RateLimitConfig `yaml:"rate_limit"`
+	CircuitBreaker map[string]BreakerConfig   `yaml:"circuit_breaker"`
+	FrequencyCaps  []FrequencyCapConfig       `yaml:"frequency_caps"`
+	IOS            []iOSAppConfig             `yaml:"ios"`
+	Huawei         []HuaweiAppConfig          `yaml:"huawei"`
+	Android        []AndroidAppConfig         `yaml:"android"`
+	Vivo           []VivoAppConfig            `yaml:"vivo"`
+	Oppo           []OppoAppConfig            `yaml:"oppo"`
+	Xiaomi         []XiaomiAppConfig          `yaml:"xiaomi"`
+	Meizu          []MeizuAppConfig           `yaml:"meizu"`
+	Honor          []HonorAppConfig           `yaml:"honor"`
 }
 
 type Storage struct {
 	Enabled bool   `yaml:"enabled"`
 	Type    string `yaml:"type"`
 	Path    string `yaml:"path"`
+	// Fsync 持久化队列的 fsync 策略 always、interval、never，默认 always
+	Fsync string `yaml:"fsync"`
+}
+
+type IdempotencyConfig struct {
+	// Window 幂等 key 的有效期（以小时为单位），默认 24
+	Window int `yaml:"window"`
+}
+
+type TemplateConfig struct {
+	// Dir 消息模板目录，启动时加载目录下的 yaml、json 模板文件，为空时只使用通过接口创建的模板
+	Dir string `yaml:"dir"`
+}
+
+type FeedbackConfig struct {
+	// Webhook 失效 token 回调地址，为空时不回调
+	Webhook string `yaml:"webhook"`
+	// Timeout 回调超时时间（以秒为单位）
+	Timeout int `yaml:"timeout"`
+}
+
+type AsyncConfig struct {
+	// Retention 已结束任务的保留时间（以小时为单位），默认 24
+	Retention int `yaml:"retention"`
+}
+
+// LaneConfig 推送通道配置，Config.Lanes 的 key 为推送优先级 high、normal、low
+type LaneConfig struct {
+	// Concurrency 同时推送的请求数量，同步推送、异步推送任务以及定时推送共用
+	Concurrency int `yaml:"concurrency"`
+	// QueueSize 等待处理的异步推送任务上限
+	QueueSize int `yaml:"queue_size"`
+}
+
+// SendConfig 应用的推送默认值，推送请求中未指定（为 0 或空）时使用
+type SendConfig struct {
+	// MaxConcurrentPushes 单次推送同时调用厂商接口的最大数量，默认 100
+	MaxConcurrentPushes int `yaml:"max_concurrent_pushes"`
+	// MaxRetry 重试次数
+	MaxRetry int `yaml:"max_retry"`
+	// RetryInterval 重试间隔（以秒为单位）
+	RetryInterval int `yaml:"retry_interval"`
+	// TTL 消息的有效期（以秒为单位）
+	TTL int64 `yaml:"ttl"`
+	// Priority 消息的推送优先级，例如 high、normal，取值与推送请求中 data.priority 相同
+	Priority string `yaml:"priority"`
+}
+
+// RateLimitConfig 调用厂商接口的限流配置，为 0 时不限制。
+// Config.RateLimit 按平台 consts.Platform 配置，应用级别的限流在各应用的配置中设置
+type RateLimitConfig struct {
+	// QPS 每秒调用厂商接口的次数
+	QPS float64 `yaml:"qps"`
+	// Burst 令牌桶容量，默认与 QPS 相同
+	Burst int `yaml:"burst"`
+	// DailyQuota 每天推送的设备数量上限，超出后拒绝推送请求
+	DailyQuota int64 `yaml:"daily_quota"`
+}
+
+// FrequencyCapConfig 推送频率控制规则，同一设备（以及设备注册表中同一用户）在 Window 内最多收到 Limit 条匹配规则的推送，
+// Platform、AppID、Category 为空时匹配所有平台、应用或消息类别，Category 与推送请求的 category 比较
+type FrequencyCapConfig struct {
+	Platform string `yaml:"platform"`
+	AppID    string `yaml:"app_id"`
+	Category string `yaml:"category"`
+	Limit    int    `yaml:"limit"`
+	// Window 滑动窗口的长度（以秒为单位），例如 3600、86400
+	Window int `yaml:"window"`
+	// Action 超过上限时的处理方式，drop 丢弃（默认），defer 推迟到窗口内有余量时推送
+	Action string `yaml:"action"`
+}
+
+// BreakerConfig 熔断器配置，Config.CircuitBreaker 按平台 consts.Platform 配置，
+// 平台下每个应用使用独立的熔断器，未配置的平台不启用熔断，为 0 的字段使用默认值
+type BreakerConfig struct {
+	// ConsecutiveFailures 连续失败次数达到该值时熔断，默认 5
+	ConsecutiveFailures int `yaml:"consecutive_failures"`
+	// ErrorRate 统计窗口内的失败率达到该值时熔断，取值 (0, 1]，默认 0.5
+	ErrorRate float64 `yaml:"error_rate"`
+	// MinRequests 统计窗口内的请求数达到该值后才按失败率熔断，默认 20
+	MinRequests int `yaml:"min_requests"`
+	// Window 失败率的统计窗口（以秒为单位），默认 60
+	Window int `yaml:"window"`
+	// OpenTimeout 熔断后经过该时间（以秒为单位）进入半开状态，默认 30
+	OpenTimeout int `yaml:"open_timeout"`
+	// HalfOpenRequests 半开状态下允许的探测请求数，全部成功后恢复，默认 1
+	HalfOpenRequests int `yaml:"half_open_requests"`
 }
 
 type HTTPConfig struct {
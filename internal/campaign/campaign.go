@@ -0,0 +1,633 @@
+package campaign
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PushCampaigns 推送活动管理器
+var PushCampaigns *Manager
+
+var (
+	ErrCampaignsDisabled = errors.New("campaign is not enabled")
+	ErrCampaignNotFound  = errors.New("campaign not found")
+	ErrInvalidCampaign   = errors.New("invalid campaign")
+	// ErrInvalidState 活动当前的状态不支持该操作，例如暂停已完成的活动
+	ErrInvalidState = errors.New("invalid campaign state")
+)
+
+// 活动状态
+const (
+	StatusCreated   = "created"
+	StatusScheduled = "scheduled"
+	StatusRunning   = "running"
+	StatusPaused    = "paused"
+	StatusCompleted = "completed"
+	StatusCancelled = "cancelled"
+)
+
+const (
+	defaultBatchSize = 500
+	// maxTaskIDs 每个活动保留的最近的任务id数量
+	maxTaskIDs = 100
+
+	campaignPrefix = "campaign/"
+	audiencePrefix = "audience/"
+)
+
+func InitPushCampaigns(cfg *config.Config, dispatcher *dispatcher.Dispatcher, logger logr.Logger) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "campaigns")
+	if err != nil {
+		return err
+	}
+
+	PushCampaigns = NewManager(s, dispatcher, logger)
+	return PushCampaigns.Start()
+}
+
+// Campaign 推送活动，推送对象的设备单独保存，Sent 为已推送的设备数量，同时也是下一批设备的起始位置
+type Campaign struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Request 推送的内容及选项，不包括推送对象的设备
+	Request      *v1.PushRequest `json:"request"`
+	Total        int             `json:"total"`
+	Sent         int             `json:"sent"`
+	SuccessCount int             `json:"success_count"`
+	FailureCount int             `json:"failure_count"`
+	CappedCount  int             `json:"capped_count,omitempty"`
+	// DeferredCount 推迟到投递时间窗口开始时或频率控制有余量时由定时推送任务推送的设备数量
+	DeferredCount int       `json:"deferred_count,omitempty"`
+	StartAt       time.Time `json:"start_at"`
+	BatchSize     int       `json:"batch_size"`
+	// Rate 每秒最多推送的设备数量，为 0 时不限制
+	Rate       int       `json:"rate"`
+	TaskIDs    []string  `json:"task_ids,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	StartedAt  time.Time `json:"started_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// Finished 活动是否已结束
+func (c *Campaign) Finished() bool {
+	return c.Status == StatusCompleted || c.Status == StatusCancelled
+}
+
+// Remaining 未推送的设备数量
+func (c *Campaign) Remaining() int {
+	return c.Total - c.Sent
+}
+
+// Manager 将推送活动及推送对象的设备保存在 store 中，已启动的活动由独立的 goroutine 按批次推送，
+// 每批推送结束后保存推送进度，重启后从保存的进度继续推送已启动的活动，
+// 因此重启前正在推送的一批设备可能会重复推送。
+// 一批设备全部推送失败（例如超出每日推送配额）时活动自动暂停，恢复后重新推送该批设备
+type Manager struct {
+	store      store.ObjectStore
+	dispatcher *dispatcher.Dispatcher
+	logger     logr.Logger
+
+	// mutex 保护 runners 以及 store 中活动的读改写
+	mutex sync.Mutex
+	// runners 已启动的活动，关闭 channel 时停止推送，goroutine 退出前不会删除
+	runners map[string]chan struct{}
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewManager(store store.ObjectStore, dispatcher *dispatcher.Dispatcher, logger logr.Logger) *Manager {
+	return &Manager{
+		store:      store,
+		dispatcher: dispatcher,
+		logger:     logger.WithValues("component", "campaign"),
+		runners:    make(map[string]chan struct{}),
+		stop:       make(chan struct{}),
+	}
+}
+
+func (m *Manager) Start() error {
+	if err := m.store.Init(); err != nil {
+		return err
+	}
+
+	var resumed []string
+	m.store.Range(campaignPrefix, func(_ string, value []byte) bool {
+		c := &Campaign{}
+		if err := json.Unmarshal(value, c); err != nil {
+			m.logger.Error(err, "failed to unmarshal campaign")
+			return true
+		}
+		if c.Status == StatusScheduled || c.Status == StatusRunning {
+			resumed = append(resumed, c.ID)
+		}
+		return true
+	})
+
+	m.mutex.Lock()
+	for _, id := range resumed {
+		m.spawn(id)
+	}
+	m.mutex.Unlock()
+
+	m.logger.Info("campaign manager started", "resumed", len(resumed))
+	return nil
+}
+
+// Close 停止推送并等待正在推送的一批设备推送结束，已启动的活动在重启后继续推送
+func (m *Manager) Close() error {
+	if m == nil {
+		return nil
+	}
+	close(m.stop)
+	m.wg.Wait()
+	return m.store.Close()
+}
+
+// Create 校验推送请求后保存活动，推送对象为 req 中的 token 与 tokens 合并去重后的设备，
+// 创建的活动需要调用 Launch 启动
+func (m *Manager) Create(name string, req *v1.PushRequest, tokens []string, startAt time.Time, batchSize, rate int) (*Campaign, error) {
+	if m == nil {
+		return nil, ErrCampaignsDisabled
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrInvalidCampaign)
+	}
+	if dispatcher.UsesRegistry(req) {
+		return nil, fmt.Errorf("%w: user_ids and segment are not supported", ErrInvalidCampaign)
+	}
+	if batchSize < 0 || rate < 0 {
+		return nil, fmt.Errorf("%w: batch_size and rate must not be negative", ErrInvalidCampaign)
+	}
+	if batchSize == 0 {
+		batchSize = defaultBatchSize
+	}
+	audience := uniqueTokens(append(append([]string(nil), req.GetToken()...), tokens...))
+	if len(audience) == 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCampaign, dispatcher.ErrTokenRequired)
+	}
+
+	r := proto.Clone(req).(*v1.PushRequest)
+	r.Token = audience[:batchLen(0, len(audience), batchSize)]
+	if err := m.dispatcher.Check(r); err != nil {
+		return nil, err
+	}
+	r.Token = nil
+	r.IdempotencyKey = ""
+	if r.Option != nil {
+		r.Option.ScheduledAt = 0
+		r.Option.Async = false
+		r.Option.Spread = 0
+	}
+
+	now := time.Now()
+	c := &Campaign{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Status:    StatusCreated,
+		Request:   r,
+		Total:     len(audience),
+		StartAt:   startAt,
+		BatchSize: batchSize,
+		Rate:      rate,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	data, err := json.Marshal(audience)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.store.Set(audiencePrefix+c.ID, data); err != nil {
+		return nil, err
+	}
+	if err := m.save(c); err != nil {
+		m.store.Del(audiencePrefix + c.ID)
+		return nil, err
+	}
+
+	m.logger.Info("campaign created", "id", c.ID, "name", name, "platform", r.GetPlatform(), "total", c.Total)
+	return c, nil
+}
+
+// Launch 启动已创建的活动，未到开始时间时等待到开始时间后推送
+func (m *Manager) Launch(id string) (*Campaign, error) {
+	return m.transition(id, []string{StatusCreated}, func(c *Campaign) error {
+		c.Status = m.startStatus(c)
+		m.spawn(c.ID)
+		return nil
+	})
+}
+
+// Pause 暂停已启动的活动，正在推送的一批设备推送结束后停止推送
+func (m *Manager) Pause(id string) (*Campaign, error) {
+	return m.transition(id, []string{StatusScheduled, StatusRunning}, func(c *Campaign) error {
+		c.Status = StatusPaused
+		m.halt(c.ID)
+		return nil
+	})
+}
+
+// Resume 恢复已暂停的活动，从暂停时的进度继续推送，暂停前正在推送的一批设备未推送结束时返回 ErrInvalidState
+func (m *Manager) Resume(id string) (*Campaign, error) {
+	return m.transition(id, []string{StatusPaused}, func(c *Campaign) error {
+		if _, ok := m.runners[c.ID]; ok {
+			return fmt.Errorf("%w: the current batch is still being sent", ErrInvalidState)
+		}
+		c.Status = m.startStatus(c)
+		c.Error = ""
+		m.spawn(c.ID)
+		return nil
+	})
+}
+
+// Cancel 取消未结束的活动，剩余的设备不再推送
+func (m *Manager) Cancel(id string) (*Campaign, error) {
+	return m.transition(id, []string{StatusCreated, StatusScheduled, StatusRunning, StatusPaused}, func(c *Campaign) error {
+		c.Status = StatusCancelled
+		c.FinishedAt = time.Now()
+		m.halt(c.ID)
+		m.store.Del(audiencePrefix + c.ID)
+		return nil
+	})
+}
+
+// transition 活动的状态为 from 之一时调用 fn 修改活动后保存，fn 返回错误时不保存
+func (m *Manager) transition(id string, from []string, fn func(c *Campaign) error) (*Campaign, error) {
+	if m == nil {
+		return nil, ErrCampaignsDisabled
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	c, err := m.load(id)
+	if err != nil {
+		return nil, err
+	}
+	allowed := false
+	for _, status := range from {
+		if c.Status == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, fmt.Errorf("%w: campaign is %s", ErrInvalidState, c.Status)
+	}
+	if err := fn(c); err != nil {
+		return nil, err
+	}
+	c.UpdatedAt = time.Now()
+	if err := m.save(c); err != nil {
+		return nil, err
+	}
+	m.logger.Info("campaign "+c.Status, "id", c.ID, "sent", c.Sent, "remaining", c.Remaining())
+	return c, nil
+}
+
+// Get 查询活动及推送进度
+func (m *Manager) Get(id string) (*Campaign, error) {
+	if m == nil {
+		return nil, ErrCampaignsDisabled
+	}
+	return m.load(id)
+}
+
+// List 查询活动，platform、appID、status 为空时不进行过滤，按创建时间倒序排列
+func (m *Manager) List(platform, appID, status string) []*Campaign {
+	if m == nil {
+		return nil
+	}
+
+	var campaigns []*Campaign
+	m.store.Range(campaignPrefix, func(_ string, value []byte) bool {
+		c := &Campaign{}
+		if err := json.Unmarshal(value, c); err != nil {
+			return true
+		}
+		if (platform == "" || c.Request.GetPlatform() == platform) &&
+			(appID == "" || c.Request.GetAppID() == appID) &&
+			(status == "" || c.Status == status) {
+			campaigns = append(campaigns, c)
+		}
+		return true
+	})
+	sort.Slice(campaigns, func(i, j int) bool {
+		return campaigns[i].CreatedAt.After(campaigns[j].CreatedAt)
+	})
+	return campaigns
+}
+
+func (m *Manager) startStatus(c *Campaign) string {
+	if c.StartAt.After(time.Now()) {
+		return StatusScheduled
+	}
+	return StatusRunning
+}
+
+// spawn 启动推送活动的 goroutine，调用方需持有锁
+func (m *Manager) spawn(id string) {
+	if _, ok := m.runners[id]; ok {
+		return
+	}
+	stop := make(chan struct{})
+	m.runners[id] = stop
+	m.wg.Add(1)
+	go m.run(id, stop)
+}
+
+// halt 停止推送活动，goroutine 推送完正在推送的一批设备后退出并从 runners 中删除，调用方需持有锁
+func (m *Manager) halt(id string) {
+	stop, ok := m.runners[id]
+	if !ok {
+		return
+	}
+	select {
+	case <-stop:
+	default:
+		close(stop)
+	}
+}
+
+// run 等待到开始时间后按批次推送活动剩余的设备，每批推送后按 Rate 等待
+func (m *Manager) run(id string, stop chan struct{}) {
+	defer m.wg.Done()
+	defer m.release(id, stop)
+
+	c, err := m.load(id)
+	if err != nil {
+		m.logger.Error(err, "failed to load campaign", "id", id)
+		return
+	}
+	tokens, err := m.audience(id)
+	if err != nil {
+		m.logger.Error(err, "failed to load campaign audience", "id", id)
+		return
+	}
+	if !m.wait(time.Until(c.StartAt), stop) {
+		return
+	}
+
+	for {
+		c, ok := m.next(id, stop)
+		if !ok {
+			return
+		}
+		batch := tokens[c.Sent:batchLen(c.Sent, len(tokens), c.BatchSize)]
+		r := proto.Clone(c.Request).(*v1.PushRequest)
+		r.Token = batch
+
+		started := time.Now()
+		resp, err := m.dispatcher.Push(context.Background(), r)
+		if !m.progress(id, stop, len(batch), resp, err) {
+			return
+		}
+		if c.Rate > 0 {
+			interval := time.Duration(len(batch)) * time.Second / time.Duration(c.Rate)
+			if !m.wait(interval-time.Since(started), stop) {
+				return
+			}
+		}
+	}
+}
+
+// next 返回推送下一批设备前的活动，活动已停止时返回 false，没有剩余的设备时将活动标记为已完成
+func (m *Manager) next(id string, stop chan struct{}) (*Campaign, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	select {
+	case <-stop:
+		return nil, false
+	case <-m.stop:
+		return nil, false
+	default:
+	}
+
+	c, err := m.load(id)
+	if err != nil {
+		m.logger.Error(err, "failed to load campaign", "id", id)
+		return nil, false
+	}
+	if c.Status == StatusScheduled {
+		c.Status = StatusRunning
+	}
+	if c.StartedAt.IsZero() {
+		c.StartedAt = time.Now()
+	}
+	if c.Remaining() <= 0 {
+		c.Status = StatusCompleted
+		c.FinishedAt = time.Now()
+		m.store.Del(audiencePrefix + c.ID)
+		m.logger.Info("campaign completed", "id", id, "success", c.SuccessCount, "failure", c.FailureCount, "capped", c.CappedCount, "deferred", c.DeferredCount)
+	}
+	c.UpdatedAt = time.Now()
+	if err := m.save(c); err != nil {
+		m.logger.Error(err, "failed to save campaign", "id", id)
+	}
+	if c.Status != StatusRunning {
+		return nil, false
+	}
+	return c, true
+}
+
+// progress 记录一批设备的推送结果，整批推送失败时不记录进度并暂停活动，返回是否继续推送
+func (m *Manager) progress(id string, stop chan struct{}, count int, resp *push.SendResponse, err error) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	c, loadErr := m.load(id)
+	if loadErr != nil {
+		m.logger.Error(loadErr, "failed to load campaign", "id", id)
+		return false
+	}
+	c.UpdatedAt = time.Now()
+	if err != nil {
+		c.Error = err.Error()
+	}
+	if resp == nil && err != nil {
+		m.logger.Error(err, "failed to push campaign batch, pausing campaign", "id", id, "sent", c.Sent)
+		if c.Status == StatusRunning {
+			c.Status = StatusPaused
+		}
+		if err := m.save(c); err != nil {
+			m.logger.Error(err, "failed to save campaign", "id", id)
+		}
+		return false
+	}
+
+	c.Sent += count
+	if resp != nil {
+		c.SuccessCount += resp.SuccessCount()
+		c.FailureCount += resp.FailureCount()
+		c.CappedCount += resp.CappedCount()
+		c.DeferredCount += resp.Deferred
+		if len(resp.TaskIDs) > 0 {
+			c.TaskIDs = append(c.TaskIDs, resp.TaskIDs...)
+		} else if resp.TaskId != "" {
+			c.TaskIDs = append(c.TaskIDs, resp.TaskId)
+		}
+		if len(c.TaskIDs) > maxTaskIDs {
+			c.TaskIDs = c.TaskIDs[len(c.TaskIDs)-maxTaskIDs:]
+		}
+	}
+	if err := m.save(c); err != nil {
+		m.logger.Error(err, "failed to save campaign", "id", id)
+	}
+	return true
+}
+
+// release 推送活动的 goroutine 退出时从 runners 中删除
+func (m *Manager) release(id string, stop chan struct{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.runners[id] == stop {
+		delete(m.runners, id)
+	}
+}
+
+// wait 等待 d，活动停止或管理器关闭时返回 false
+func (m *Manager) wait(d time.Duration, stop chan struct{}) bool {
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-stop:
+		return false
+	case <-m.stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (m *Manager) audience(id string) ([]string, error) {
+	value, ok := m.store.Get(audiencePrefix + id)
+	if !ok {
+		return nil, ErrCampaignNotFound
+	}
+	var tokens []string
+	if err := json.Unmarshal(value, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (m *Manager) load(id string) (*Campaign, error) {
+	value, ok := m.store.Get(campaignPrefix + id)
+	if !ok {
+		return nil, ErrCampaignNotFound
+	}
+	c := &Campaign{}
+	if err := json.Unmarshal(value, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (m *Manager) save(c *Campaign) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return m.store.Set(campaignPrefix+c.ID, data)
+}
+
+// ParseTokens 解析上传的设备文件，每行一个 token，也可以使用逗号分隔，忽略空行及 # 开头的行
+func ParseTokens(data []byte) ([]string, error) {
+	var tokens []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, token := range strings.Split(line, ",") {
+			if token = strings.TrimSpace(token); token != "" {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCampaign, err)
+	}
+	return tokens, nil
+}
+
+// uniqueTokens 去除重复及空的 token，保留第一次出现的顺序
+func uniqueTokens(tokens []string) []string {
+	seen := make(map[string]bool, len(tokens))
+	result := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token == "" || seen[token] {
+			continue
+		}
+		seen[token] = true
+		result = append(result, token)
+	}
+	return result
+}
+
+// batchLen 从 offset 开始的一批设备的结束位置
+func batchLen(offset, total, batchSize int) int {
+	if end := offset + batchSize; end < total {
+		return end
+	}
+	return total
+}
+
+// Campaign 转换为 pb 结构
+func (c *Campaign) Campaign() *v1.Campaign {
+	campaign := &v1.Campaign{
+		ID:            c.ID,
+		Name:          c.Name,
+		Status:        c.Status,
+		Platform:      c.Request.GetPlatform(),
+		AppID:         c.Request.GetAppID(),
+		Total:         int32(c.Total),
+		Sent:          int32(c.Sent),
+		SuccessCount:  int32(c.SuccessCount),
+		FailureCount:  int32(c.FailureCount),
+		CappedCount:   int32(c.CappedCount),
+		DeferredCount: int32(c.DeferredCount),
+		Remaining:     int32(c.Remaining()),
+		BatchSize:     int32(c.BatchSize),
+		Rate:          int32(c.Rate),
+		TaskIDs:       c.TaskIDs,
+		Error:         c.Error,
+		CreatedAt:     c.CreatedAt.Unix(),
+		UpdatedAt:     c.UpdatedAt.Unix(),
+	}
+	if !c.StartAt.IsZero() {
+		campaign.StartAt = c.StartAt.Unix()
+	}
+	if !c.StartedAt.IsZero() {
+		campaign.StartedAt = c.StartedAt.Unix()
+	}
+	if !c.FinishedAt.IsZero() {
+		campaign.FinishedAt = c.FinishedAt.Unix()
+	}
+	return campaign
+}
@@ -0,0 +1,305 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/internal/factory"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-logr/logr"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestParseTokens 测试按行及逗号解析设备文件，忽略空行及注释，合并后去除重复的 token
+func TestParseTokens(t *testing.T) {
+	tokens, err := ParseTokens([]byte("# exported tokens\na, b\r\n\nc\nb\n  d  \n"))
+	if err != nil {
+		t.Fatalf("ParseTokens() error = %v", err)
+	}
+	want := []string{"a", "b", "c", "b", "d"}
+	if !reflect.DeepEqual(tokens, want) {
+		t.Fatalf("ParseTokens() = %v, want %v", tokens, want)
+	}
+
+	unique := uniqueTokens(append([]string{"d", ""}, tokens...))
+	if want := []string{"d", "a", "b", "c"}; !reflect.DeepEqual(unique, want) {
+		t.Errorf("uniqueTokens() = %v, want %v", unique, want)
+	}
+}
+
+// stubService 记录每批推送的设备，gate 不为空时每批推送前等待 gate，fail 为 true 时整批推送失败
+type stubService struct {
+	mutex   sync.Mutex
+	batches [][]string
+	fail    bool
+	sending chan struct{}
+	gate    chan struct{}
+}
+
+func (s *stubService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	s.mutex.Lock()
+	gate, sending, fail := s.gate, s.sending, s.fail
+	s.mutex.Unlock()
+	if sending != nil {
+		sending <- struct{}{}
+	}
+	if gate != nil {
+		<-gate
+	}
+	if fail {
+		return nil, errors.New("quota exceeded")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.batches = append(s.batches, req.GetToken())
+	resp := &push.SendResponse{TaskId: "task"}
+	for _, token := range req.GetToken() {
+		resp.Results = append(resp.Results, push.TokenResult{Token: token, Success: true})
+	}
+	return resp, nil
+}
+
+func (s *stubService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	return s.Send(ctx, req)
+}
+
+func (s *stubService) GetTasksStatus(ctx context.Context, appid string, taskID []string, obj push.TaskObjectList) error {
+	return nil
+}
+
+func (s *stubService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) Name() string {
+	return "ios"
+}
+
+func (s *stubService) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	return nil, nil
+}
+
+func (s *stubService) set(fn func(s *stubService)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	fn(s)
+}
+
+func (s *stubService) sent() [][]string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([][]string(nil), s.batches...)
+}
+
+func newTestManager(t *testing.T, s store.ObjectStore, service *stubService) *Manager {
+	f := factory.NewPushServiceFactory()
+	if err := f.Register(f.WithPushService(service)); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	m := NewManager(s, dispatcher.New(f), logr.Discard())
+	if err := m.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	return m
+}
+
+func createCampaign(t *testing.T, m *Manager, tokens []string, startAt time.Time) *Campaign {
+	c, err := m.Create("test", &v1.PushRequest{Platform: "ios", AppID: "app"}, tokens, startAt, 2, 0)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return c
+}
+
+// waitStatus 等待活动的状态变为 status 并且推送活动的 goroutine 已退出或仍在推送
+func waitStatus(t *testing.T, m *Manager, id, status string, running bool) *Campaign {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c, err := m.Get(id)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		m.mutex.Lock()
+		_, ok := m.runners[id]
+		m.mutex.Unlock()
+		if c.Status == status && ok == running {
+			return c
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("campaign status = %s (runner %v), want %s (runner %v)", c.Status, ok, status, running)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestCampaignComplete 测试启动后按批次推送所有设备后完成
+func TestCampaignComplete(t *testing.T) {
+	service := &stubService{}
+	m := newTestManager(t, store.NewMemoryObjectStore(), service)
+	defer m.Close()
+
+	c := createCampaign(t, m, []string{"a", "b", "c", "d", "e"}, time.Time{})
+	if c.Status != StatusCreated || c.Total != 5 {
+		t.Fatalf("Create() = %+v", c)
+	}
+	if c, err := m.Launch(c.ID); err != nil || c.Status != StatusRunning {
+		t.Fatalf("Launch() = %+v, %v", c, err)
+	}
+
+	c = waitStatus(t, m, c.ID, StatusCompleted, false)
+	if c.Sent != 5 || c.SuccessCount != 5 || c.Remaining() != 0 || c.FinishedAt.IsZero() {
+		t.Errorf("completed campaign = %+v", c)
+	}
+	if want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}; !reflect.DeepEqual(service.sent(), want) {
+		t.Errorf("sent batches = %v, want %v", service.sent(), want)
+	}
+	if _, err := m.Pause(c.ID); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Pause() completed campaign error = %v, want %v", err, ErrInvalidState)
+	}
+}
+
+// TestCampaignPauseResume 测试暂停时推送完正在推送的一批设备，推送结束前不能恢复，恢复后从保存的进度继续推送
+func TestCampaignPauseResume(t *testing.T) {
+	service := &stubService{sending: make(chan struct{}), gate: make(chan struct{})}
+	m := newTestManager(t, store.NewMemoryObjectStore(), service)
+	defer m.Close()
+
+	c := createCampaign(t, m, []string{"a", "b", "c", "d", "e"}, time.Time{})
+	if _, err := m.Launch(c.ID); err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	<-service.sending
+	if c, err := m.Pause(c.ID); err != nil || c.Status != StatusPaused {
+		t.Fatalf("Pause() = %+v, %v", c, err)
+	}
+	if _, err := m.Resume(c.ID); !errors.Is(err, ErrInvalidState) {
+		t.Fatalf("Resume() while sending error = %v, want %v", err, ErrInvalidState)
+	}
+
+	gate := service.gate
+	service.set(func(s *stubService) { s.sending, s.gate = nil, nil })
+	close(gate)
+	c = waitStatus(t, m, c.ID, StatusPaused, false)
+	if c.Sent != 2 || c.SuccessCount != 2 {
+		t.Fatalf("paused campaign = %+v", c)
+	}
+
+	if c, err := m.Resume(c.ID); err != nil || c.Status != StatusRunning {
+		t.Fatalf("Resume() = %+v, %v", c, err)
+	}
+	c = waitStatus(t, m, c.ID, StatusCompleted, false)
+	if c.Sent != 5 || c.SuccessCount != 5 {
+		t.Errorf("completed campaign = %+v", c)
+	}
+	if want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}; !reflect.DeepEqual(service.sent(), want) {
+		t.Errorf("sent batches = %v, want %v", service.sent(), want)
+	}
+}
+
+// TestCampaignCancel 测试取消未开始及正在推送的活动后不再推送剩余的设备
+func TestCampaignCancel(t *testing.T) {
+	service := &stubService{}
+	m := newTestManager(t, store.NewMemoryObjectStore(), service)
+	defer m.Close()
+
+	// Test cancel scheduled campaign
+	c := createCampaign(t, m, []string{"a", "b", "c"}, time.Now().Add(time.Hour))
+	if c, err := m.Launch(c.ID); err != nil || c.Status != StatusScheduled {
+		t.Fatalf("Launch() = %+v, %v", c, err)
+	}
+	if c, err := m.Cancel(c.ID); err != nil || c.Status != StatusCancelled {
+		t.Fatalf("Cancel() = %+v, %v", c, err)
+	}
+	waitStatus(t, m, c.ID, StatusCancelled, false)
+	if len(service.sent()) != 0 {
+		t.Errorf("sent batches = %v, want none", service.sent())
+	}
+	if _, err := m.Resume(c.ID); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Resume() cancelled campaign error = %v, want %v", err, ErrInvalidState)
+	}
+
+	// Test cancel running campaign after the current batch
+	service.set(func(s *stubService) { s.sending, s.gate = make(chan struct{}), make(chan struct{}) })
+	c = createCampaign(t, m, []string{"a", "b", "c"}, time.Time{})
+	if _, err := m.Launch(c.ID); err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	<-service.sending
+	if _, err := m.Cancel(c.ID); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	close(service.gate)
+	c = waitStatus(t, m, c.ID, StatusCancelled, false)
+	if c.Sent != 2 || len(service.sent()) != 1 {
+		t.Errorf("cancelled campaign = %+v, sent batches = %v", c, service.sent())
+	}
+}
+
+// TestCampaignAutoPause 测试一批设备全部推送失败时自动暂停，恢复后重新推送该批设备
+func TestCampaignAutoPause(t *testing.T) {
+	service := &stubService{fail: true}
+	m := newTestManager(t, store.NewMemoryObjectStore(), service)
+	defer m.Close()
+
+	c := createCampaign(t, m, []string{"a", "b", "c"}, time.Time{})
+	if _, err := m.Launch(c.ID); err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	c = waitStatus(t, m, c.ID, StatusPaused, false)
+	if c.Sent != 0 || c.Error == "" {
+		t.Fatalf("paused campaign = %+v", c)
+	}
+
+	service.set(func(s *stubService) { s.fail = false })
+	if c, err := m.Resume(c.ID); err != nil || c.Error != "" {
+		t.Fatalf("Resume() = %+v, %v", c, err)
+	}
+	c = waitStatus(t, m, c.ID, StatusCompleted, false)
+	if want := [][]string{{"a", "b"}, {"c"}}; c.Sent != 3 || !reflect.DeepEqual(service.sent(), want) {
+		t.Errorf("completed campaign = %+v, sent batches = %v", c, service.sent())
+	}
+}
+
+// TestCampaignRestart 测试重启后从保存的进度继续推送正在推送的活动
+func TestCampaignRestart(t *testing.T) {
+	s := store.NewMemoryObjectStore()
+	service := &stubService{}
+	m := newTestManager(t, s, service)
+	c := createCampaign(t, m, []string{"a", "b", "c", "d", "e"}, time.Time{})
+
+	// 模拟重启前已推送第一批设备
+	c.Status = StatusRunning
+	c.Sent = 2
+	c.SuccessCount = 2
+	if err := m.save(c); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+
+	m = newTestManager(t, s, service)
+	defer m.Close()
+	c = waitStatus(t, m, c.ID, StatusCompleted, false)
+	if c.Sent != 5 || c.SuccessCount != 5 {
+		t.Errorf("completed campaign = %+v", c)
+	}
+	if want := [][]string{{"c", "d"}, {"e"}}; !reflect.DeepEqual(service.sent(), want) {
+		t.Errorf("sent batches = %v, want %v", service.sent(), want)
+	}
+}
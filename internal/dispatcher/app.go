@@ -0,0 +1,61 @@
+package dispatcher
+
+import (
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/consts"
+)
+
+// pushApps 各平台已启用应用的应用名称到应用 id 的映射，与推送服务的 appNameToIDMap 一致，
+// 应用只配置了 app_name 时应用 id 为 app_name
+var pushApps = map[string]map[string]string{}
+
+// InitPushApps 记录各平台已启用的应用，按应用名称推送时使用对应的应用 id 查找设备注册表
+func InitPushApps(cfg *config.Config) {
+	apps := make(map[string]map[string]string)
+	add := func(platform consts.Platform, enabled bool, appID, appName string) {
+		if !enabled || appName == "" {
+			return
+		}
+		if apps[platform.String()] == nil {
+			apps[platform.String()] = make(map[string]string)
+		}
+		if appID == "" {
+			appID = appName
+		}
+		apps[platform.String()][appName] = appID
+	}
+	for _, app := range cfg.IOS {
+		add(consts.PlatformIOS, app.Enabled, app.AppID, app.AppName)
+	}
+	for _, app := range cfg.Android {
+		add(consts.PlatformAndroid, app.Enabled, app.AppID, app.AppName)
+	}
+	for _, app := range cfg.Huawei {
+		add(consts.PlatformHuawei, app.Enabled, app.AppID, app.AppName)
+	}
+	for _, app := range cfg.Xiaomi {
+		add(consts.PlatformXiaomi, app.Enabled, app.AppID, app.AppName)
+	}
+	for _, app := range cfg.Vivo {
+		add(consts.PlatformVivo, app.Enabled, app.AppID, app.AppName)
+	}
+	for _, app := range cfg.Oppo {
+		add(consts.PlatformOppo, app.Enabled, app.AppID, app.AppName)
+	}
+	for _, app := range cfg.Meizu {
+		add(consts.PlatformMeizu, app.Enabled, app.AppID, app.AppName)
+	}
+	for _, app := range cfg.Honor {
+		add(consts.PlatformHonor, app.Enabled, app.AppID, app.AppName)
+	}
+	pushApps = apps
+}
+
+// AppID 推送请求的应用 id，请求只指定了应用名称时按配置转换，应用名称未配置时返回空
+func AppID(req *v1.PushRequest) string {
+	if req.GetAppID() != "" {
+		return req.GetAppID()
+	}
+	return pushApps[req.GetPlatform()][req.GetAppName()]
+}
@@ -0,0 +1,167 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/factory"
+	"github.com/cossim/hipush/internal/lane"
+)
+
+// Dispatcher 将推送请求分发给对应平台的推送服务，供定时推送等不经过 HTTP、gRPC 处理的推送使用
+type Dispatcher struct {
+	factory *factory.PushServiceFactory
+}
+
+func New(factory *factory.PushServiceFactory) *Dispatcher {
+	return &Dispatcher{factory: factory}
+}
+
+// Push 根据推送选项调用厂商单推或批量推送接口，推送前占用请求优先级对应推送通道的并发额度，
+// 按用户或分群推送时推送到设备注册表中对应的设备，不在投递时间窗口内的设备推迟到窗口开始时推送，
+// 指定了内容变体时按变体拆分后推送并记录每个变体的统计
+func (d *Dispatcher) Push(ctx context.Context, req *v1.PushRequest) (*push.SendResponse, error) {
+	if UsesRegistry(req) {
+		return d.pushUsers(ctx, req)
+	}
+	if len(req.GetVariants()) > 0 {
+		return d.pushVariants(ctx, req)
+	}
+
+	service, r, err := d.prepare(req)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := lane.PushLanes.Acquire(ctx, req.GetPriority())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	resp, err := Deliver(ctx, req, r, func(r push.SendRequest) (*push.SendResponse, error) {
+		return send(ctx, service, req.GetOption(), r)
+	})
+	recordVariant(req, resp, err)
+	return resp, err
+}
+
+// Send 供 HTTP、gRPC 处理直接推送已构建的推送请求 r，请求指定了多语言内容时按设备的语言推送，
+// 不在投递时间窗口内的设备推迟到窗口开始时推送
+func Send(ctx context.Context, service push.PushService, req *v1.PushRequest, r push.SendRequest) (*push.SendResponse, error) {
+	return Deliver(ctx, req, r, func(r push.SendRequest) (*push.SendResponse, error) {
+		return send(ctx, service, req.GetOption(), Localize(req, req.Localized, r))
+	})
+}
+
+// send 根据推送选项调用厂商单推或批量推送接口
+func send(ctx context.Context, service push.PushService, option *v1.PushOption, r push.SendRequest) (*push.SendResponse, error) {
+	if option.GetMulticast() {
+		return service.Multicast(ctx, r, &push.MulticastOptions{
+			DryRun:        option.GetDryRun(),
+			Development:   option.GetDevelopment(),
+			Retry:         option.GetRetry(),
+			RetryInterval: option.GetRetryInterval(),
+			MaxDevices:    int(option.GetMaxDevices()),
+			Delay:         int(option.GetDelay()),
+		})
+	}
+	return service.Send(ctx, r, &push.SendOptions{
+		DryRun:        option.GetDryRun(),
+		Development:   option.GetDevelopment(),
+		Retry:         option.GetRetry(),
+		RetryInterval: option.GetRetryInterval(),
+	})
+}
+
+// Validate 只校验推送请求，不实际推送
+func (d *Dispatcher) Validate(ctx context.Context, req *v1.PushRequest) error {
+	if UsesRegistry(req) {
+		return d.checkUsers(req)
+	}
+	service, r, err := d.prepare(req)
+	if err != nil {
+		return err
+	}
+	_, err = service.Send(ctx, r, &push.SendOptions{DryRun: true})
+	return err
+}
+
+// Check 只校验推送平台及推送请求的格式，不调用厂商接口，供异步推送等需要快速返回的场景使用
+func (d *Dispatcher) Check(req *v1.PushRequest) error {
+	if UsesRegistry(req) {
+		return d.checkUsers(req)
+	}
+	_, r, err := d.prepare(req)
+	if err != nil {
+		return err
+	}
+	if len(r.GetToken()) == 0 {
+		return ErrTokenRequired
+	}
+	return nil
+}
+
+func (d *Dispatcher) prepare(req *v1.PushRequest) (push.PushService, push.SendRequest, error) {
+	if _, err := lane.Normalize(req.GetPriority()); err != nil {
+		return nil, nil, err
+	}
+	if _, err := ParseWindow(req.GetOption().GetDeliveryWindow()); err != nil {
+		return nil, nil, err
+	}
+	if err := CheckVariants(req); err != nil {
+		return nil, nil, err
+	}
+	service, err := d.factory.GetPushService(req.Platform)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, localized, err := TemplateData(req.Platform, req.TemplateID, req.Variables, req.Data, req.Localized)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := NewSendRequest(req.Platform, &v1.Meta{
+		AppID:   req.AppID,
+		AppName: req.AppName,
+		Token:   req.Token,
+	}, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return service, Localize(req, localized, r), nil
+}
+
+// ResultMsg 根据每个设备的推送结果生成响应消息，部分设备推送失败时返回部分成功，
+// 有设备超过推送频率上限或推迟推送时返回对应的设备数量
+func ResultMsg(resp *push.SendResponse) string {
+	if resp != nil && (resp.CappedCount() > 0 || resp.Deferred > 0) {
+		return fmt.Sprintf("Push notification sent, success: %d, failure: %d, capped: %d, deferred: %d", resp.SuccessCount(), resp.FailureCount(), resp.CappedCount(), resp.Deferred)
+	}
+	if resp.PartialSuccess() {
+		return fmt.Sprintf("Push notification partially sent, success: %d, failure: %d", resp.SuccessCount(), resp.FailureCount())
+	}
+	return "Push notification send success"
+}
+
+// TokenResults 将每个设备的推送结果转换为 pb 结构
+func TokenResults(resp *push.SendResponse) []*v1.TokenResult {
+	if resp == nil {
+		return nil
+	}
+	results := make([]*v1.TokenResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		results = append(results, &v1.TokenResult{
+			Token:         r.Token,
+			Success:       r.Success,
+			Code:          r.Code,
+			Msg:           r.Msg,
+			MessageID:     r.MessageID,
+			Attempts:      int32(r.Attempts),
+			InvalidReason: r.InvalidReason,
+			Capped:        r.Capped,
+		})
+	}
+	return results
+}
@@ -0,0 +1,26 @@
+package dispatcher
+
+import (
+	"github.com/cossim/hipush/api/push"
+	"testing"
+)
+
+// TestResultMsg 测试全部成功、部分成功以及有设备超过频率上限或推迟推送时的响应消息
+func TestResultMsg(t *testing.T) {
+	tests := []struct {
+		name string
+		resp *push.SendResponse
+		want string
+	}{
+		{"no response", nil, "Push notification send success"},
+		{"success", &push.SendResponse{Results: []push.TokenResult{{Token: "a", Success: true}}}, "Push notification send success"},
+		{"partial", &push.SendResponse{Results: []push.TokenResult{{Token: "a", Success: true}, {Token: "b"}}}, "Push notification partially sent, success: 1, failure: 1"},
+		{"capped", &push.SendResponse{Results: []push.TokenResult{{Token: "a", Success: true}, {Token: "b", Capped: true}}}, "Push notification sent, success: 1, failure: 0, capped: 1, deferred: 0"},
+		{"deferred", &push.SendResponse{Deferred: 2}, "Push notification sent, success: 0, failure: 0, capped: 0, deferred: 2"},
+	}
+	for _, tt := range tests {
+		if got := ResultMsg(tt.resp); got != tt.want {
+			t.Errorf("%s: ResultMsg() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
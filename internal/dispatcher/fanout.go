@@ -0,0 +1,141 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/lane"
+	"github.com/cossim/hipush/pkg/frequency"
+	"google.golang.org/protobuf/types/known/structpb"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrTargetRequired 多平台推送请求未指定推送目标
+	ErrTargetRequired = errors.New("targets is required")
+	// ErrFanoutOption 多平台推送不支持定时推送、异步推送及均匀推送
+	ErrFanoutOption = errors.New("scheduled, async and spread push are not supported for multi-target push")
+)
+
+// CheckFanout 校验多平台推送请求及每个目标，不调用厂商接口
+func (d *Dispatcher) CheckFanout(req *v1.MultiPushRequest) error {
+	if req.GetOption().GetScheduledAt() > time.Now().Unix() || req.GetOption().GetAsync() || req.GetOption().GetSpread() > 0 {
+		return ErrFanoutOption
+	}
+	return d.checkTargets(req)
+}
+
+func (d *Dispatcher) checkTargets(req *v1.MultiPushRequest) error {
+	if len(req.GetTargets()) == 0 {
+		return ErrTargetRequired
+	}
+	if _, err := lane.Normalize(req.GetPriority()); err != nil {
+		return err
+	}
+	for i, target := range req.GetTargets() {
+		if err := d.Check(TargetRequest(req, target)); err != nil {
+			return fmt.Errorf("targets[%d] %s: %w", i, target.GetPlatform(), err)
+		}
+	}
+	return nil
+}
+
+// Fanout 将公共推送内容并发推送到每个目标对应平台的推送服务，返回每个目标的推送结果，
+// 只有所有目标都推送失败时才返回错误
+func (d *Dispatcher) Fanout(ctx context.Context, req *v1.MultiPushRequest) ([]*v1.PushTargetResult, error) {
+	if err := d.CheckFanout(req); err != nil {
+		return nil, err
+	}
+
+	resps, errs := d.fanout(ctx, req)
+	results := make([]*v1.PushTargetResult, len(resps))
+	for i, target := range req.GetTargets() {
+		results[i] = &v1.PushTargetResult{
+			Platform: target.GetPlatform(),
+			AppID:    target.GetAppID(),
+			AppName:  target.GetAppName(),
+			Success:  errs[i] == nil,
+			Results:  TokenResults(resps[i]),
+		}
+		if resps[i] != nil {
+			results[i].Deferred = int32(resps[i].Deferred)
+		}
+		if errs[i] != nil {
+			results[i].Msg = errs[i].Error()
+		}
+	}
+	return results, fanoutError(req.GetTargets(), errs)
+}
+
+// fanout 并发推送每个目标，返回每个目标的推送结果及错误，顺序与 req.Targets 相同，
+// 用户在多个目标中的设备只计入一次推送频率
+func (d *Dispatcher) fanout(ctx context.Context, req *v1.MultiPushRequest) ([]*push.SendResponse, []error) {
+	ctx = frequency.WithAdmitted(ctx)
+	targets := req.GetTargets()
+	resps := make([]*push.SendResponse, len(targets))
+	errs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target *v1.PushTarget) {
+			defer wg.Done()
+			resps[i], errs[i] = d.Push(ctx, TargetRequest(req, target))
+		}(i, target)
+	}
+	wg.Wait()
+	return resps, errs
+}
+
+// fanoutError 只有所有目标都推送失败时返回错误
+func fanoutError(targets []*v1.PushTarget, errs []error) error {
+	wrapped := make([]error, 0, len(errs))
+	for i, err := range errs {
+		if err == nil {
+			return nil
+		}
+		wrapped = append(wrapped, fmt.Errorf("%s: %w", targets[i].GetPlatform(), err))
+	}
+	return errors.Join(wrapped...)
+}
+
+// TargetRequest 将多平台推送请求中的一个目标转换为单平台推送请求，
+// 目标的 Data 覆盖公共推送内容中的同名字段
+func TargetRequest(req *v1.MultiPushRequest, target *v1.PushTarget) *v1.PushRequest {
+	return &v1.PushRequest{
+		AppID:          target.GetAppID(),
+		AppName:        target.GetAppName(),
+		Platform:       target.GetPlatform(),
+		Token:          target.GetToken(),
+		Data:           mergeData(req.GetData(), target.GetData()),
+		Option:         req.GetOption(),
+		Priority:       req.GetPriority(),
+		TemplateID:     req.GetTemplateID(),
+		Variables:      req.GetVariables(),
+		Localized:      req.GetLocalized(),
+		TokenLocales:   req.GetTokenLocales(),
+		TokenTimezones: req.GetTokenTimezones(),
+		Category:       req.GetCategory(),
+		Experiment:     req.GetExperiment(),
+		Variants:       req.GetVariants(),
+	}
+}
+
+func mergeData(common, data *structpb.Struct) *structpb.Struct {
+	if len(data.GetFields()) == 0 {
+		return common
+	}
+	if len(common.GetFields()) == 0 {
+		return data
+	}
+	merged := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(common.Fields)+len(data.Fields))}
+	for k, v := range common.Fields {
+		merged.Fields[k] = v
+	}
+	for k, v := range data.Fields {
+		merged.Fields[k] = v
+	}
+	return merged
+}
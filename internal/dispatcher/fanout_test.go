@@ -0,0 +1,39 @@
+package dispatcher
+
+import (
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+	"testing"
+)
+
+func TestTargetRequest(t *testing.T) {
+	common, _ := structpb.NewStruct(map[string]interface{}{"title": "hello", "content": "world"})
+	data, _ := structpb.NewStruct(map[string]interface{}{"title": "hi", "badge": 1})
+	req := &v1.MultiPushRequest{
+		Data:     common,
+		Priority: "high",
+		Targets: []*v1.PushTarget{
+			{Platform: "ios", AppID: "app", Token: []string{"a"}, Data: data},
+			{Platform: "xiaomi", AppID: "app", Token: []string{"b"}},
+		},
+	}
+
+	// Test target data overrides common data
+	r := TargetRequest(req, req.Targets[0])
+	fields := r.GetData().AsMap()
+	if fields["title"] != "hi" || fields["content"] != "world" || fields["badge"] != float64(1) {
+		t.Errorf("TargetRequest failed: unexpected data %v", fields)
+	}
+	if r.Platform != "ios" || r.Priority != "high" || len(r.Token) != 1 {
+		t.Errorf("TargetRequest failed: unexpected request %v", r)
+	}
+	if common.Fields["title"].GetStringValue() != "hello" {
+		t.Errorf("TargetRequest failed: common data was modified")
+	}
+
+	// Test target without data uses common data
+	r = TargetRequest(req, req.Targets[1])
+	if r.GetData().AsMap()["title"] != "hello" {
+		t.Errorf("TargetRequest failed: unexpected data %v", r.GetData().AsMap())
+	}
+}
@@ -0,0 +1,76 @@
+package dispatcher
+
+import (
+	"context"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/device"
+	"github.com/cossim/hipush/pkg/frequency"
+	"sort"
+	"time"
+)
+
+// 超过推送频率上限的设备的推送结果状态码
+const (
+	// CodeCapped 设备已丢弃
+	CodeCapped = "capped"
+	// CodeCappedDeferred 设备推迟到频率控制有余量时推送，同时计入推送结果的 Deferred
+	CodeCappedDeferred = "capped_deferred"
+)
+
+// capTokens 按推送频率控制规则检查设备，返回未超过上限的设备以及超过上限的设备的推送结果，
+// 规则为 defer 的设备按重新有余量的时间加入 deferred，ctx 中记录的用户在同一次推送的多个请求中只计入一次
+func capTokens(ctx context.Context, req *v1.PushRequest, tokens []string, deferred map[int64][]string, now time.Time) ([]string, []push.TokenResult) {
+	userOf := func(token string) string {
+		d, _ := device.PushDevices.Get(req.GetPlatform(), AppID(req), token)
+		return d.UserID
+	}
+	allowed, capped := frequency.PushCapper.Admit(req.GetPlatform(), AppID(req), req.GetCategory(), tokens, userOf, frequency.AdmittedFrom(ctx), now)
+
+	results := make([]push.TokenResult, 0, len(capped))
+	for _, c := range capped {
+		result := push.TokenResult{
+			Token:  c.Token,
+			Code:   CodeCapped,
+			Msg:    "frequency cap exceeded: " + c.Rule.String(),
+			Capped: true,
+		}
+		if c.Action == frequency.ActionDefer {
+			// 向上取整到秒，推送时该设备一定已有余量
+			at := c.RetryAt.Truncate(time.Second).Add(time.Second)
+			deferred[at.Unix()] = append(deferred[at.Unix()], c.Token)
+			result.Code = CodeCappedDeferred
+			result.Msg += ", deferred to " + at.Format(time.RFC3339)
+		}
+		results = append(results, result)
+	}
+	return allowed, results
+}
+
+// sortResults 将推送结果按请求中 token 的顺序排序
+func sortResults(results []push.TokenResult, tokens []string) []push.TokenResult {
+	index := make(map[string]int, len(tokens))
+	for i, token := range tokens {
+		if _, ok := index[token]; !ok {
+			index[token] = i
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return index[results[i].Token] < index[results[j].Token]
+	})
+	return results
+}
+
+// TokenCount 推送结果对应的设备数量，包括推迟推送的设备
+func TokenCount(resp *push.SendResponse) int {
+	if resp == nil {
+		return 0
+	}
+	n := len(resp.Results) + resp.Deferred
+	for _, r := range resp.Results {
+		if r.Code == CodeCappedDeferred {
+			n--
+		}
+	}
+	return n
+}
@@ -0,0 +1,31 @@
+package dispatcher
+
+import (
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/device"
+)
+
+// Localize 为推送请求附加多语言内容及每个设备的语言，由推送服务按设备的语言选择标题及内容，
+// 设备的语言优先使用请求的 token_locales，其次使用设备注册表中设备的语言。localized 为空时直接返回 r
+func Localize(req *v1.PushRequest, localized map[string]*v1.LocalizedContent, r push.SendRequest) push.SendRequest {
+	if len(localized) == 0 {
+		return r
+	}
+
+	contents := make(map[string]push.LocalizedContent, len(localized))
+	for l, c := range localized {
+		contents[l] = push.LocalizedContent{Title: c.GetTitle(), Content: c.GetContent()}
+	}
+	tokenLocales := make(map[string]string, len(req.GetToken()))
+	for _, token := range req.GetToken() {
+		if l := req.GetTokenLocales()[token]; l != "" {
+			tokenLocales[token] = l
+			continue
+		}
+		if d, ok := device.PushDevices.Get(req.GetPlatform(), AppID(req), token); ok && d.Locale != "" {
+			tokenLocales[token] = d.Locale
+		}
+	}
+	return push.WithLocalization(r, contents, tokenLocales)
+}
@@ -0,0 +1,58 @@
+package dispatcher
+
+import (
+	"encoding/json"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/consts"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	// ErrPlatformNotSupported 不支持的推送平台
+	ErrPlatformNotSupported = errors.New("platform not supported")
+	// ErrTokenRequired 推送请求未指定设备
+	ErrTokenRequired = errors.New("token is required")
+)
+
+// NewSendRequest 根据推送平台将 data 解析为对应厂商的推送请求
+func NewSendRequest(platform string, meta *v1.Meta, data *structpb.Struct) (push.SendRequest, error) {
+	if meta == nil {
+		meta = &v1.Meta{}
+	}
+
+	var r push.SendRequest
+	switch consts.Platform(platform) {
+	case consts.PlatformIOS:
+		r = &v1.APNsPushRequest{Meta: meta}
+	case consts.PlatformAndroid:
+		r = &v1.AndroidPushRequestData{Meta: meta}
+	case consts.PlatformHuawei:
+		r = &v1.HuaweiPushRequestData{Meta: meta}
+	case consts.PlatformXiaomi:
+		r = &v1.XiaomiPushRequestData{Meta: meta}
+	case consts.PlatformVivo:
+		r = &v1.VivoPushRequestData{Meta: meta}
+	case consts.PlatformOppo:
+		r = &v1.OppoPushRequestData{Meta: meta}
+	case consts.PlatformMeizu:
+		r = &v1.MeizuPushRequestData{Meta: meta}
+	case consts.PlatformHonor:
+		r = &v1.HonorPushRequestData{Meta: meta}
+	default:
+		return nil, ErrPlatformNotSupported
+	}
+
+	if data == nil {
+		return r, nil
+	}
+	dataBytes, err := data.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(dataBytes, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
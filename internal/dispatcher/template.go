@@ -0,0 +1,79 @@
+package dispatcher
+
+import (
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/template"
+	"google.golang.org/protobuf/types/known/structpb"
+	"reflect"
+	"strings"
+)
+
+// TemplateData 使用消息模板渲染推送平台的推送内容，模板的标题、内容、点击动作覆盖 data 中的同名字段，
+// 模板的自定义数据与 data 中的自定义数据合并，同名字段使用 data 中的值，
+// 模板的多语言内容与 localized 合并，同一语言使用 localized 中的内容。templateID 为空时直接返回 data 及 localized
+func TemplateData(platform, templateID string, variables, data *structpb.Struct, localized map[string]*v1.LocalizedContent) (*structpb.Struct, map[string]*v1.LocalizedContent, error) {
+	if templateID == "" {
+		return data, localized, nil
+	}
+	t, err := template.PushTemplates.Get(templateID)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := t.Render(platform, variables.AsMap())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(m.Localized) > 0 {
+		merged := make(map[string]*v1.LocalizedContent, len(m.Localized)+len(localized))
+		for l, c := range m.Localized {
+			merged[l] = &v1.LocalizedContent{Title: c.Title, Content: c.Content}
+		}
+		for l, c := range localized {
+			merged[l] = c
+		}
+		localized = merged
+	}
+
+	fields := data.AsMap()
+	if m.Title != "" {
+		fields["title"] = m.Title
+	}
+	if m.Content != "" {
+		fields["content"] = m.Content
+	}
+	if a := m.ClickAction; a != nil {
+		action := map[string]interface{}{"action": a.Action, "activity": a.Activity, "url": a.Url}
+		if len(a.Parameters) > 0 {
+			action["parameters"] = a.Parameters
+		}
+		fields[clickActionKey(platform)] = action
+	}
+	if len(m.Data) > 0 {
+		custom := m.Data
+		if d, ok := fields["data"].(map[string]interface{}); ok {
+			for k, v := range d {
+				custom[k] = v
+			}
+		}
+		fields["data"] = custom
+	}
+	s, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, localized, nil
+}
+
+// clickActionKey 推送平台请求中点击动作字段的 json 名称，部分平台的点击动作字段未指定 json 名称
+func clickActionKey(platform string) string {
+	r, err := NewSendRequest(platform, nil, nil)
+	if err != nil {
+		return "click_action"
+	}
+	if f, ok := reflect.TypeOf(r).Elem().FieldByName("ClickAction"); ok {
+		if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" {
+			return name
+		}
+	}
+	return "click_action"
+}
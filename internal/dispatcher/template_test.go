@@ -0,0 +1,47 @@
+package dispatcher
+
+import (
+	"github.com/cossim/hipush/internal/template"
+	"github.com/cossim/hipush/pkg/store"
+	"google.golang.org/protobuf/types/known/structpb"
+	"testing"
+)
+
+func TestTemplateData(t *testing.T) {
+	template.PushTemplates = template.NewStore(store.NewMemoryObjectStore())
+	defer func() { template.PushTemplates = nil }()
+	if _, err := template.PushTemplates.Save(&template.Template{
+		ID: "welcome",
+		Message: template.Message{
+			Title:       "Welcome {{.name}}",
+			ClickAction: &template.ClickAction{Action: 2, Url: "https://example.com"},
+			Data:        map[string]interface{}{"type": "welcome", "source": "template"},
+		},
+	}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	data, _ := structpb.NewStruct(map[string]interface{}{"content": "hello", "data": map[string]interface{}{"source": "request"}})
+	variables, _ := structpb.NewStruct(map[string]interface{}{"name": "cossim"})
+
+	// Test template fields are merged into data and the click action uses the platform field name
+	for platform, key := range map[string]string{"ios": "ClickAction", "xiaomi": "click_action"} {
+		rendered, _, err := TemplateData(platform, "welcome", variables, data, nil)
+		if err != nil {
+			t.Fatalf("TemplateData failed: %v", err)
+		}
+		m := rendered.AsMap()
+		custom, _ := m["data"].(map[string]interface{})
+		if m["title"] != "Welcome cossim" || m["content"] != "hello" || custom["type"] != "welcome" || custom["source"] != "request" {
+			t.Errorf("TemplateData %s failed: unexpected data %v", platform, m)
+		}
+		if _, ok := m[key]; !ok {
+			t.Errorf("TemplateData %s failed: expected click action in %s but got %v", platform, key, m)
+		}
+	}
+
+	// Test unknown template
+	if _, _, err := TemplateData("ios", "unknown", variables, data, nil); err != template.ErrTemplateNotFound {
+		t.Errorf("TemplateData failed: expected ErrTemplateNotFound but got %v", err)
+	}
+}
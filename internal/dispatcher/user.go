@@ -0,0 +1,114 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/lane"
+	"github.com/cossim/hipush/pkg/device"
+)
+
+var (
+	// ErrUserIDsWithToken 推送请求同时指定了设备及用户或分群
+	ErrUserIDsWithToken = errors.New("token cannot be used together with user_ids or segment")
+	// ErrNoDevice 推送的用户或分群在设备注册表中没有设备
+	ErrNoDevice = errors.New("no registered device for user_ids or segment")
+)
+
+// UsesRegistry 推送请求是否按用户或分群推送，需要在设备注册表中查找设备
+func UsesRegistry(req *v1.PushRequest) bool {
+	return len(req.GetUserIDs()) > 0 || req.GetSegment() != ""
+}
+
+// DeviceQuery 根据推送请求的用户、分群表达式、平台及应用生成查找设备的条件
+func DeviceQuery(userIDs []string, segment, platform, appID string) (device.Query, error) {
+	q := device.Query{UserIDs: userIDs, Platform: platform, AppID: appID}
+	if segment != "" {
+		s, err := device.ParseSegment(segment)
+		if err != nil {
+			return q, err
+		}
+		q.Segment = s
+	}
+	return q, nil
+}
+
+// UsersRequest 根据设备注册表将按用户或分群推送的请求转换为多平台推送请求，
+// 请求的 Platform、AppID（或 AppName）不为空时只推送到对应平台及应用的设备
+func UsersRequest(req *v1.PushRequest) (*v1.MultiPushRequest, error) {
+	if len(req.GetToken()) > 0 {
+		return nil, ErrUserIDsWithToken
+	}
+	q, err := DeviceQuery(req.GetUserIDs(), req.GetSegment(), req.GetPlatform(), AppID(req))
+	if err != nil {
+		return nil, err
+	}
+	targets := device.PushDevices.Resolve(q)
+	if len(targets) == 0 {
+		return nil, ErrNoDevice
+	}
+
+	m := &v1.MultiPushRequest{
+		Data:           req.GetData(),
+		Option:         req.GetOption(),
+		Priority:       req.GetPriority(),
+		TemplateID:     req.GetTemplateID(),
+		Variables:      req.GetVariables(),
+		Localized:      req.GetLocalized(),
+		TokenTimezones: req.GetTokenTimezones(),
+		Category:       req.GetCategory(),
+		Experiment:     req.GetExperiment(),
+		Variants:       req.GetVariants(),
+	}
+	for _, t := range targets {
+		m.Targets = append(m.Targets, &v1.PushTarget{Platform: t.Platform, AppID: t.AppID, Token: t.Tokens})
+	}
+	return m, nil
+}
+
+// checkUsers 校验按用户或分群推送的请求，设备在推送时才查找
+func (d *Dispatcher) checkUsers(req *v1.PushRequest) error {
+	if len(req.GetToken()) > 0 {
+		return ErrUserIDsWithToken
+	}
+	if _, err := DeviceQuery(req.GetUserIDs(), req.GetSegment(), req.GetPlatform(), AppID(req)); err != nil {
+		return err
+	}
+	if _, err := lane.Normalize(req.GetPriority()); err != nil {
+		return err
+	}
+	if _, err := ParseWindow(req.GetOption().GetDeliveryWindow()); err != nil {
+		return err
+	}
+	if err := CheckVariants(req); err != nil {
+		return err
+	}
+	if req.GetPlatform() != "" {
+		if _, err := d.factory.GetPushService(req.GetPlatform()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pushUsers 推送到用户注册的设备或匹配分群的设备，不同平台及应用的设备并发推送，合并所有设备的推送结果
+func (d *Dispatcher) pushUsers(ctx context.Context, req *v1.PushRequest) (*push.SendResponse, error) {
+	m, err := UsersRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.checkTargets(m); err != nil {
+		return nil, err
+	}
+
+	resps, errs := d.fanout(ctx, m)
+	resp := &push.SendResponse{}
+	for _, r := range resps {
+		if r != nil {
+			resp.Results = append(resp.Results, r.Results...)
+			resp.Deferred += r.Deferred
+		}
+	}
+	return resp, fanoutError(m.Targets, errs)
+}
@@ -0,0 +1,160 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/experiment"
+	"github.com/cossim/hipush/pkg/frequency"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"log"
+)
+
+// ErrInvalidVariants A/B 测试的内容变体错误
+var ErrInvalidVariants = errors.New("invalid variants")
+
+// CheckVariants 校验推送请求的内容变体，变体名称不能为空或重复，权重必须大于 0
+func CheckVariants(req *v1.PushRequest) error {
+	if len(req.GetVariants()) == 0 {
+		return nil
+	}
+	if req.GetExperiment() == "" {
+		return fmt.Errorf("%w: experiment is required", ErrInvalidVariants)
+	}
+	names := make(map[string]bool, len(req.GetVariants()))
+	for i, v := range req.GetVariants() {
+		if v.GetName() == "" || names[v.GetName()] {
+			return fmt.Errorf("%w: variants[%d] name is empty or duplicated", ErrInvalidVariants, i)
+		}
+		if v.GetWeight() <= 0 {
+			return fmt.Errorf("%w: variants[%d] weight must be greater than 0", ErrInvalidVariants, i)
+		}
+		names[v.GetName()] = true
+	}
+	return nil
+}
+
+// VariantRequests 将设备按 experiment.Assign 分配到变体，返回每个有设备的变体对应的推送请求，
+// 请求的 Data 为公共推送内容与变体内容合并的结果
+func VariantRequests(req *v1.PushRequest) []*v1.PushRequest {
+	variants := req.GetVariants()
+	weights := make([]int, len(variants))
+	for i, v := range variants {
+		weights[i] = int(v.GetWeight())
+	}
+	groups := make([][]string, len(variants))
+	for _, token := range req.GetToken() {
+		if i := experiment.Assign(req.GetExperiment(), token, weights); i >= 0 {
+			groups[i] = append(groups[i], token)
+		}
+	}
+
+	var reqs []*v1.PushRequest
+	for i, v := range variants {
+		if len(groups[i]) == 0 {
+			continue
+		}
+		r := proto.Clone(req).(*v1.PushRequest)
+		r.Token = groups[i]
+		r.Variants = nil
+		r.Variant = v.GetName()
+		r.Data = variantData(req.GetData(), v)
+		reqs = append(reqs, r)
+	}
+	return reqs
+}
+
+// variantData 合并公共推送内容与变体的内容，变体的标题及内容不为空时覆盖 title、content
+func variantData(data *structpb.Struct, v *v1.Variant) *structpb.Struct {
+	merged := mergeData(data, v.GetData())
+	if v.GetTitle() == "" && v.GetContent() == "" {
+		return merged
+	}
+	fields := make(map[string]*structpb.Value, len(merged.GetFields())+2)
+	for k, value := range merged.GetFields() {
+		fields[k] = value
+	}
+	if v.GetTitle() != "" {
+		fields["title"] = structpb.NewStringValue(v.GetTitle())
+	}
+	if v.GetContent() != "" {
+		fields["content"] = structpb.NewStringValue(v.GetContent())
+	}
+	return &structpb.Struct{Fields: fields}
+}
+
+// pushVariants 按变体拆分推送请求后依次推送，合并所有变体的推送结果，只有所有变体都推送失败时才返回错误
+func (d *Dispatcher) pushVariants(ctx context.Context, req *v1.PushRequest) (*push.SendResponse, error) {
+	if err := CheckVariants(req); err != nil {
+		return nil, err
+	}
+	variants := make([]experiment.Variant, 0, len(req.GetVariants()))
+	for _, v := range req.GetVariants() {
+		variants = append(variants, experiment.Variant{Name: v.GetName(), Weight: int(v.GetWeight())})
+	}
+	if err := experiment.PushExperiments.Declare(req.GetExperiment(), variants); err != nil {
+		log.Printf("failed to save experiment %s: %v", req.GetExperiment(), err)
+	}
+
+	// 用户的设备分到不同变体时只计入一次推送频率
+	ctx = frequency.WithAdmitted(ctx)
+	reqs := VariantRequests(req)
+	resp := &push.SendResponse{}
+	var errs []error
+	for _, r := range reqs {
+		vr, err := d.Push(ctx, r)
+		if vr != nil {
+			resp.Results = append(resp.Results, vr.Results...)
+			resp.TaskIDs = append(resp.TaskIDs, taskIDs(vr)...)
+			resp.Deferred += vr.Deferred
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("variant %s: %w", r.GetVariant(), err))
+		}
+	}
+	resp.Results = sortResults(resp.Results, req.GetToken())
+	if len(errs) > 0 && len(errs) == len(reqs) {
+		return resp, errors.Join(errs...)
+	}
+	return resp, nil
+}
+
+// recordVariant 记录变体请求的推送结果，不是变体请求时不记录
+func recordVariant(req *v1.PushRequest, resp *push.SendResponse, err error) {
+	if req.GetVariant() == "" || req.GetExperiment() == "" {
+		return
+	}
+
+	var r experiment.Result
+	if resp != nil {
+		r.Success = resp.SuccessCount()
+		r.Failure = resp.FailureCount()
+		r.Send = r.Success + r.Failure
+		r.TaskIDs = taskIDs(resp)
+		for _, result := range resp.Results {
+			if !result.Capped {
+				r.Tokens = append(r.Tokens, result.Token)
+			}
+		}
+	} else if err != nil {
+		r.Send = len(req.GetToken())
+		r.Failure = r.Send
+		r.Tokens = req.GetToken()
+	}
+	if r.Send == 0 && len(r.TaskIDs) == 0 {
+		return
+	}
+	if err := experiment.PushExperiments.Record(req.GetExperiment(), req.GetVariant(), r); err != nil {
+		log.Printf("failed to record experiment %s variant %s: %v", req.GetExperiment(), req.GetVariant(), err)
+	}
+}
+
+func taskIDs(resp *push.SendResponse) []string {
+	if len(resp.TaskIDs) == 0 && resp.TaskId != "" {
+		return []string{resp.TaskId}
+	}
+	return resp.TaskIDs
+}
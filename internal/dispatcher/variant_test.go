@@ -0,0 +1,62 @@
+package dispatcher
+
+import (
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+	"testing"
+)
+
+// TestVariantRequests 测试按变体拆分设备以及变体内容覆盖公共推送内容
+func TestVariantRequests(t *testing.T) {
+	data, _ := structpb.NewStruct(map[string]interface{}{"title": "common", "content": "body"})
+	req := &v1.PushRequest{
+		Platform:   "ios",
+		Experiment: "exp",
+		Data:       data,
+		Variants: []*v1.Variant{
+			{Name: "a", Weight: 1, Title: "title a"},
+			{Name: "b", Weight: 1, Content: "content b"},
+		},
+	}
+	for i := 0; i < 100; i++ {
+		req.Token = append(req.Token, fmt.Sprintf("token-%d", i))
+	}
+	if err := CheckVariants(req); err != nil {
+		t.Fatalf("CheckVariants() error = %v", err)
+	}
+
+	reqs := VariantRequests(req)
+	if len(reqs) != 2 {
+		t.Fatalf("VariantRequests() returned %d requests, want 2", len(reqs))
+	}
+	var total int
+	for _, r := range reqs {
+		total += len(r.Token)
+		if len(r.Variants) != 0 || r.Experiment != "exp" {
+			t.Errorf("variant %s request = %v, want experiment exp without variants", r.Variant, r)
+		}
+		fields := r.Data.GetFields()
+		switch r.Variant {
+		case "a":
+			if fields["title"].GetStringValue() != "title a" || fields["content"].GetStringValue() != "body" {
+				t.Errorf("variant a data = %v, want title a and common content", r.Data)
+			}
+		case "b":
+			if fields["title"].GetStringValue() != "common" || fields["content"].GetStringValue() != "content b" {
+				t.Errorf("variant b data = %v, want common title and content b", r.Data)
+			}
+		}
+	}
+	if total != len(req.Token) {
+		t.Errorf("variant requests have %d tokens, want %d", total, len(req.Token))
+	}
+	if req.Data.GetFields()["title"].GetStringValue() != "common" {
+		t.Errorf("original data modified: %v", req.Data)
+	}
+
+	req.Variants = append(req.Variants, &v1.Variant{Name: "a", Weight: 1})
+	if err := CheckVariants(req); err == nil {
+		t.Errorf("CheckVariants() with duplicated name error = nil")
+	}
+}
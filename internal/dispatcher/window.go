@@ -0,0 +1,195 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/device"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"time"
+)
+
+var (
+	// ErrInvalidDeliveryWindow 投递时间窗口格式错误
+	ErrInvalidDeliveryWindow = errors.New("invalid delivery window")
+	// ErrDeferDisabled 没有可以保存推迟推送的请求的定时推送调度器
+	ErrDeferDisabled = errors.New("deferred push requires the push scheduler")
+)
+
+// Deferrer 保存推迟到投递时间窗口开始时或频率控制有余量时推送的请求，到达推送时间后调用 Dispatcher.Push 推送
+type Deferrer interface {
+	Defer(req *v1.PushRequest, at time.Time) (string, error)
+}
+
+// PushDeferrer 推迟推送的请求由定时推送调度器保存
+var PushDeferrer Deferrer
+
+// Window 解析后的投递时间窗口，start、end 为一天中的分钟数
+type Window struct {
+	start, end int
+	location   *time.Location
+}
+
+// ParseWindow 解析投递时间窗口，未指定开始及结束时间时返回 nil
+func ParseWindow(w *v1.DeliveryWindow) (*Window, error) {
+	if w.GetStart() == "" && w.GetEnd() == "" {
+		return nil, nil
+	}
+	start, err := parseClock(w.GetStart())
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseClock(w.GetEnd())
+	if err != nil {
+		return nil, err
+	}
+	if start == end {
+		return nil, fmt.Errorf("%w: start and end are the same", ErrInvalidDeliveryWindow)
+	}
+
+	location := time.Local
+	if w.GetTimezone() != "" {
+		if location, err = time.LoadLocation(w.GetTimezone()); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidDeliveryWindow, err)
+		}
+	}
+	return &Window{start: start, end: end, location: location}, nil
+}
+
+// parseClock 将 HH:MM 解析为一天中的分钟数
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not in HH:MM format", ErrInvalidDeliveryWindow, s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Next 返回 now 在 location 时区是否在窗口内，不在窗口内时同时返回下一次窗口开始的时间
+func (w *Window) Next(now time.Time, location *time.Location) (time.Time, bool) {
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	if w.start < w.end && minute >= w.start && minute < w.end {
+		return now, true
+	}
+	if w.start > w.end && (minute >= w.start || minute < w.end) {
+		return now, true
+	}
+
+	day := local.Day()
+	if minute >= w.start {
+		day++
+	}
+	return time.Date(local.Year(), local.Month(), day, w.start/60, w.start%60, 0, 0, location), false
+}
+
+// tokenLocation 设备的时区，优先使用请求的 token_timezones，其次使用设备注册表中设备的时区，
+// 都没有或时区无效时使用窗口的时区
+func (w *Window) tokenLocation(req *v1.PushRequest, token string) *time.Location {
+	name := req.GetTokenTimezones()[token]
+	if name == "" {
+		if d, ok := device.PushDevices.Get(req.GetPlatform(), AppID(req), token); ok {
+			name = d.Timezone
+		}
+	}
+	if name != "" {
+		if location, err := time.LoadLocation(name); err == nil {
+			return location
+		}
+	}
+	return w.location
+}
+
+// Deliver 推送请求指定了投递时间窗口时，将设备所在时区的当前时间不在窗口内的设备按窗口开始的时间分组，
+// 交给 PushDeferrer 到时间后推送，其余的设备按推送频率控制规则检查后调用 send 立即推送，
+// 超过频率上限的设备按规则丢弃或推迟，在推送结果中标记为 capped。返回的推送结果记录推迟的设备数量，
+// 所有设备都被推迟或超过上限时不调用 send，只校验数据的推送不推迟也不进行频率控制
+func Deliver(ctx context.Context, req *v1.PushRequest, r push.SendRequest, send func(r push.SendRequest) (*push.SendResponse, error)) (*push.SendResponse, error) {
+	w, err := ParseWindow(req.GetOption().GetDeliveryWindow())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetOption().GetDryRun() {
+		return send(r)
+	}
+
+	now := time.Now()
+	all := r.GetToken()
+	tokens := all
+	deferred := make(map[int64][]string)
+	if w != nil {
+		tokens = nil
+		for _, token := range all {
+			at, ok := w.Next(now, w.tokenLocation(req, token))
+			if ok {
+				tokens = append(tokens, token)
+				continue
+			}
+			deferred[at.Unix()] = append(deferred[at.Unix()], token)
+		}
+	}
+	tokens, capped := capTokens(ctx, req, tokens, deferred, now)
+
+	count, err := deferTokens(req, deferred)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return &push.SendResponse{Results: capped, Deferred: count}, nil
+	}
+	if len(tokens) < len(all) {
+		r = push.WithTokens(r, tokens)
+	}
+	resp, err := send(r)
+	if resp != nil {
+		resp.Deferred = count
+		if len(capped) > 0 {
+			resp.Results = sortResults(append(resp.Results, capped...), all)
+		}
+	}
+	return resp, err
+}
+
+// deferTokens 将推迟的设备按推送时间交给 PushDeferrer，返回推迟的设备数量，deferred 的 key 为 unix 时间戳
+func deferTokens(req *v1.PushRequest, deferred map[int64][]string) (int, error) {
+	if len(deferred) == 0 {
+		return 0, nil
+	}
+	if PushDeferrer == nil {
+		return 0, ErrDeferDisabled
+	}
+
+	times := make([]int64, 0, len(deferred))
+	for at := range deferred {
+		times = append(times, at)
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i] < times[j]
+	})
+	var count int
+	for _, at := range times {
+		if _, err := PushDeferrer.Defer(deferredRequest(req, deferred[at]), time.Unix(at, 0)); err != nil {
+			return count, err
+		}
+		count += len(deferred[at])
+	}
+	return count, nil
+}
+
+// deferredRequest 推迟推送的设备对应的推送请求，推送到时由 Dispatcher.Push 推送
+func deferredRequest(req *v1.PushRequest, tokens []string) *v1.PushRequest {
+	r := proto.Clone(req).(*v1.PushRequest)
+	r.Token = tokens
+	r.UserIDs = nil
+	r.Segment = ""
+	r.IdempotencyKey = ""
+	if r.Option != nil {
+		r.Option.ScheduledAt = 0
+		r.Option.Async = false
+		r.Option.Spread = 0
+	}
+	return r
+}
@@ -0,0 +1,148 @@
+package dispatcher
+
+import (
+	"context"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/device"
+	"github.com/cossim/hipush/pkg/store"
+	"testing"
+	"time"
+)
+
+type deferrerFunc func(req *v1.PushRequest, at time.Time) (string, error)
+
+func (f deferrerFunc) Defer(req *v1.PushRequest, at time.Time) (string, error) {
+	return f(req, at)
+}
+
+// TestWindow 测试投递时间窗口的判断及跨越午夜的窗口
+func TestWindow(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip(err)
+	}
+	now := time.Date(2024, 5, 1, 23, 30, 0, 0, shanghai)
+
+	w, err := ParseWindow(&v1.DeliveryWindow{Start: "08:00", End: "22:00"})
+	if err != nil {
+		t.Fatalf("ParseWindow() error = %v", err)
+	}
+	at, ok := w.Next(now, shanghai)
+	if want := time.Date(2024, 5, 2, 8, 0, 0, 0, shanghai); ok || !at.Equal(want) {
+		t.Errorf("Next() = %v, %v, want %v, false", at, ok, want)
+	}
+	if _, ok := w.Next(now, time.UTC); !ok {
+		t.Errorf("Next() at 15:30 UTC = false, want true")
+	}
+
+	w, _ = ParseWindow(&v1.DeliveryWindow{Start: "20:00", End: "02:00"})
+	if _, ok := w.Next(now, shanghai); !ok {
+		t.Errorf("Next() at 23:30 in 20:00-02:00 = false, want true")
+	}
+	at, ok = w.Next(now, time.UTC)
+	if want := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC); ok || !at.Equal(want) {
+		t.Errorf("Next() = %v, %v, want %v, false", at, ok, want)
+	}
+
+	for _, window := range []*v1.DeliveryWindow{
+		{Start: "8am", End: "22:00"},
+		{Start: "08:00", End: "08:00"},
+		{Start: "08:00", End: "22:00", Timezone: "Mars/Olympus"},
+	} {
+		if _, err := ParseWindow(window); err == nil {
+			t.Errorf("ParseWindow(%v) error = nil, want error", window)
+		}
+	}
+}
+
+// TestDeliver 测试不在投递时间窗口内的设备按设备时区推迟推送
+func TestDeliver(t *testing.T) {
+	var deferred []string
+	PushDeferrer = deferrerFunc(func(req *v1.PushRequest, at time.Time) (string, error) {
+		deferred = append(deferred, req.Token...)
+		if !at.After(time.Now()) {
+			t.Errorf("Defer() at %v, want a future time", at)
+		}
+		return "id", nil
+	})
+	defer func() { PushDeferrer = nil }()
+
+	// 当前时间一定在其中一个时区的窗口内，不在另一个时区的窗口内
+	now := time.Now().UTC()
+	open := now.Add(-time.Hour).Format("15:04")
+	end := now.Add(time.Hour).Format("15:04")
+	req := &v1.PushRequest{
+		Platform:       "xiaomi",
+		Token:          []string{"a", "b"},
+		Option:         &v1.PushOption{DeliveryWindow: &v1.DeliveryWindow{Start: open, End: end, Timezone: "UTC"}},
+		TokenTimezones: map[string]string{"b": "Etc/GMT-12"},
+	}
+	r, err := NewSendRequest(req.Platform, &v1.Meta{Token: req.Token}, nil)
+	if err != nil {
+		t.Fatalf("NewSendRequest() error = %v", err)
+	}
+
+	var sent []string
+	resp, err := Deliver(context.Background(), req, r, func(r push.SendRequest) (*push.SendResponse, error) {
+		sent = r.GetToken()
+		return &push.SendResponse{}, nil
+	})
+	if err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if len(sent) != 1 || sent[0] != "a" {
+		t.Errorf("sent tokens = %v, want [a]", sent)
+	}
+	if len(deferred) != 1 || deferred[0] != "b" || resp.Deferred != 1 {
+		t.Errorf("deferred tokens = %v, Deferred = %d, want [b], 1", deferred, resp.Deferred)
+	}
+}
+
+// TestDeliverAppName 测试只指定应用名称的请求按配置的应用 id 查找设备注册表中设备的时区
+func TestDeliverAppName(t *testing.T) {
+	var deferred []string
+	PushDeferrer = deferrerFunc(func(req *v1.PushRequest, at time.Time) (string, error) {
+		deferred = append(deferred, req.Token...)
+		return "id", nil
+	})
+	defer func() { PushDeferrer = nil }()
+
+	defer func(devices *device.Registry) { device.PushDevices = devices }(device.PushDevices)
+	device.PushDevices = device.NewRegistry(store.NewMemoryObjectStore(), nil)
+	if _, err := device.PushDevices.Register(device.Device{UserID: "u", Platform: "xiaomi", AppID: "com.test", Token: "b", Timezone: "Etc/GMT-12"}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	defer InitPushApps(&config.Config{})
+	InitPushApps(&config.Config{Xiaomi: []config.XiaomiAppConfig{
+		{Enabled: true, AppName: "test", AppID: "com.test"},
+		{Enabled: false, AppName: "disabled", AppID: "com.disabled"},
+	}})
+
+	req := &v1.PushRequest{Platform: "xiaomi", AppName: "test", Token: []string{"a", "b"}}
+	if got := AppID(req); got != "com.test" {
+		t.Fatalf("AppID() = %q, want com.test", got)
+	}
+	if got := AppID(&v1.PushRequest{Platform: "xiaomi", AppName: "disabled"}); got != "" {
+		t.Errorf("AppID() of disabled app = %q, want empty", got)
+	}
+
+	now := time.Now().UTC()
+	req.Option = &v1.PushOption{DeliveryWindow: &v1.DeliveryWindow{Start: now.Add(-time.Hour).Format("15:04"), End: now.Add(time.Hour).Format("15:04"), Timezone: "UTC"}}
+	r, err := NewSendRequest(req.Platform, &v1.Meta{AppName: req.AppName, Token: req.Token}, nil)
+	if err != nil {
+		t.Fatalf("NewSendRequest() error = %v", err)
+	}
+
+	var sent []string
+	if _, err := Deliver(context.Background(), req, r, func(r push.SendRequest) (*push.SendResponse, error) {
+		sent = r.GetToken()
+		return &push.SendResponse{}, nil
+	}); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if len(sent) != 1 || sent[0] != "a" || len(deferred) != 1 || deferred[0] != "b" {
+		t.Errorf("sent = %v, deferred = %v, want [a], [b]", sent, deferred)
+	}
+}
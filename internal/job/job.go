@@ -0,0 +1,497 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/internal/lane"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+// PushJobs 异步推送任务管理器
+var PushJobs *Manager
+
+var (
+	ErrJobsDisabled = errors.New("async push is not enabled")
+	ErrJobNotFound  = errors.New("push job not found")
+	ErrQueueFull    = errors.New("push job queue is full")
+)
+
+// 任务状态
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+const (
+	defaultRetention = 24 * time.Hour
+	cleanupInterval  = time.Hour
+)
+
+func InitPushJobs(cfg *config.Config, dispatcher *dispatcher.Dispatcher, logger logr.Logger) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "push_jobs")
+	if err != nil {
+		return err
+	}
+	q, err := store.NewQueue(cfg.Storage.Type, cfg.Storage.Path, "push_jobs", cfg.Storage.Fsync)
+	if err != nil {
+		return err
+	}
+
+	PushJobs = NewManager(s, q, dispatcher, lane.PushLanes, cfg.Async, logger)
+	return PushJobs.Start()
+}
+
+// Job 异步推送任务
+type Job struct {
+	ID           string          `json:"id"`
+	Status       string          `json:"status"`
+	Request      *v1.PushRequest `json:"request"`
+	Total        int             `json:"total"`
+	Processed    int             `json:"processed"`
+	SuccessCount int             `json:"success_count"`
+	FailureCount int             `json:"failure_count"`
+	// CappedCount 超过推送频率上限未推送的设备数量
+	CappedCount int `json:"capped_count,omitempty"`
+	// DeferredCount 推迟到投递时间窗口开始时或频率控制有余量时由定时推送任务推送的设备数量
+	DeferredCount int `json:"deferred_count,omitempty"`
+	// Offset 均匀推送的任务已推送的设备数量，服务停止后从该位置继续推送
+	Offset int `json:"offset,omitempty"`
+	// ProjectedAt 均匀推送的任务预计推送完成的时间，其他任务为空
+	ProjectedAt *time.Time         `json:"projected_at,omitempty"`
+	TaskIDs     []string           `json:"task_ids,omitempty"`
+	Error       string             `json:"error,omitempty"`
+	Results     []push.TokenResult `json:"results,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	StartedAt   time.Time          `json:"started_at"`
+	FinishedAt  time.Time          `json:"finished_at"`
+}
+
+// Finished 任务是否已结束
+func (j *Job) Finished() bool {
+	return j.Status == StatusCompleted || j.Status == StatusFailed
+}
+
+// Manager 将异步推送任务放入队列后由 worker 依次处理，任务状态及推送结果保存在 store 中，
+// 推送过程中的进度保存在内存中，推送结束后写入 store。
+// 任务在返回给调用方之前写入持久化队列，推送结束后才从队列中确认，
+// 重启后会重新推送未确认的任务，因此重启前正在推送的任务可能会重复推送。
+// 每个优先级使用独立的队列及 worker，worker 数量与推送通道的并发额度一致。
+// 均匀推送的任务由独立的 goroutine 分批推送，服务停止时保存进度，重启后只推送剩余的设备
+type Manager struct {
+	store      store.ObjectStore
+	wal        store.Queue
+	dispatcher *dispatcher.Dispatcher
+	logger     logr.Logger
+	lanes      *lane.Lanes
+	retention  time.Duration
+	queues     map[string]chan string
+
+	mutex   sync.Mutex
+	running map[string]*Job
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewManager(store store.ObjectStore, wal store.Queue, dispatcher *dispatcher.Dispatcher, lanes *lane.Lanes, cfg config.AsyncConfig, logger logr.Logger) *Manager {
+	if lanes == nil {
+		lanes = lane.New(nil)
+	}
+	queues := make(map[string]chan string, len(lane.Priorities))
+	for _, priority := range lane.Priorities {
+		l, _ := lanes.Get(priority)
+		queues[priority] = make(chan string, l.QueueSize)
+	}
+	retention := defaultRetention
+	if cfg.Retention > 0 {
+		retention = time.Duration(cfg.Retention) * time.Hour
+	}
+	return &Manager{
+		store:      store,
+		wal:        wal,
+		dispatcher: dispatcher,
+		logger:     logger.WithValues("component", "job"),
+		lanes:      lanes,
+		retention:  retention,
+		queues:     queues,
+		running:    make(map[string]*Job),
+		stop:       make(chan struct{}),
+	}
+}
+
+func (m *Manager) Start() error {
+	if err := m.store.Init(); err != nil {
+		return err
+	}
+	if err := m.wal.Init(); err != nil {
+		return err
+	}
+
+	// 重新推送队列中未确认的任务，任务状态未写入 store 时使用队列中保存的任务
+	pending := make(map[string]bool)
+	replay := make(map[string][]string)
+	for _, entry := range m.wal.Pending() {
+		job, err := m.load(entry.ID)
+		if err != nil {
+			job = &Job{}
+			if err := json.Unmarshal(entry.Value, job); err != nil {
+				m.logger.Error(err, "failed to unmarshal queued push job", "id", entry.ID)
+				m.wal.Ack(entry.ID)
+				continue
+			}
+		}
+		job.reset()
+		if err := m.save(job); err != nil {
+			m.logger.Error(err, "failed to save push job", "id", job.ID)
+		}
+		pending[job.ID] = true
+		priority := job.priority()
+		replay[priority] = append(replay[priority], job.ID)
+	}
+
+	// 不在队列中的未结束任务无法恢复，标记为失败
+	var interrupted []*Job
+	m.store.Range("", func(_ string, value []byte) bool {
+		job := &Job{}
+		if err := json.Unmarshal(value, job); err != nil {
+			m.logger.Error(err, "failed to unmarshal push job")
+			return true
+		}
+		if !job.Finished() && !pending[job.ID] {
+			interrupted = append(interrupted, job)
+		}
+		return true
+	})
+	for _, job := range interrupted {
+		job.Status = StatusFailed
+		job.Error = "interrupted by server restart"
+		job.FinishedAt = time.Now()
+		if err := m.save(job); err != nil {
+			m.logger.Error(err, "failed to save push job", "id", job.ID)
+		}
+	}
+
+	for _, priority := range lane.Priorities {
+		l, _ := m.lanes.Get(priority)
+		m.wg.Add(l.Capacity + 1)
+		for i := 0; i < l.Capacity; i++ {
+			go m.work(m.queues[priority])
+		}
+		go m.replay(m.queues[priority], replay[priority])
+	}
+	m.wg.Add(1)
+	go m.cleanup()
+
+	m.logger.Info("push job manager started", "replayed", len(pending), "interrupted", len(interrupted))
+	return nil
+}
+
+// Close 停止接收新任务并等待正在推送的任务结束，队列中未开始的任务会在重启后重新推送
+func (m *Manager) Close() error {
+	if m == nil {
+		return nil
+	}
+	close(m.stop)
+	m.wg.Wait()
+	if err := m.wal.Close(); err != nil {
+		m.logger.Error(err, "failed to close push job queue")
+	}
+	return m.store.Close()
+}
+
+// Submit 校验推送请求后将任务放入队列，队列已满时返回 ErrQueueFull
+func (m *Manager) Submit(req *v1.PushRequest) (*Job, error) {
+	if m == nil {
+		return nil, ErrJobsDisabled
+	}
+	if err := m.dispatcher.Check(req); err != nil {
+		return nil, err
+	}
+	if err := CheckSpread(req); err != nil {
+		return nil, err
+	}
+	priority, err := lane.Normalize(req.GetPriority())
+	if err != nil {
+		return nil, err
+	}
+
+	job := &Job{
+		ID:        uuid.New().String(),
+		Status:    StatusQueued,
+		Request:   req,
+		Total:     len(req.GetToken()),
+		CreatedAt: time.Now(),
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.store.Set(job.ID, data); err != nil {
+		return nil, err
+	}
+	if err := m.wal.Enqueue(job.ID, data); err != nil {
+		m.store.Del(job.ID)
+		return nil, err
+	}
+
+	select {
+	case m.queues[priority] <- job.ID:
+	default:
+		m.wal.Ack(job.ID)
+		m.store.Del(job.ID)
+		return nil, ErrQueueFull
+	}
+
+	m.logger.Info("push job queued", "id", job.ID, "platform", req.GetPlatform(), "priority", priority, "total", job.Total)
+	return job, nil
+}
+
+// Get 查询任务状态，推送中的任务返回当前的进度
+func (m *Manager) Get(id string) (*Job, error) {
+	if m == nil {
+		return nil, ErrJobsDisabled
+	}
+
+	m.mutex.Lock()
+	if job, ok := m.running[id]; ok {
+		snapshot := *job
+		snapshot.Results = append([]push.TokenResult(nil), job.Results...)
+		m.mutex.Unlock()
+		return &snapshot, nil
+	}
+	m.mutex.Unlock()
+
+	return m.load(id)
+}
+
+// replay 将重启前未确认的任务放入队列
+func (m *Manager) replay(queue chan<- string, ids []string) {
+	defer m.wg.Done()
+	for _, id := range ids {
+		select {
+		case <-m.stop:
+			return
+		case queue <- id:
+		}
+	}
+}
+
+func (m *Manager) work(queue <-chan string) {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case id := <-queue:
+			m.process(id)
+		}
+	}
+}
+
+func (m *Manager) process(id string) {
+	job, err := m.load(id)
+	if err != nil {
+		m.logger.Error(err, "failed to load push job", "id", id)
+		return
+	}
+
+	m.mutex.Lock()
+	job.Status = StatusRunning
+	if job.StartedAt.IsZero() {
+		job.StartedAt = time.Now()
+	}
+	m.running[id] = job
+	m.mutex.Unlock()
+	if err := m.save(job); err != nil {
+		m.logger.Error(err, "failed to save push job", "id", id)
+	}
+
+	ctx := push.WithProgress(context.Background(), func(r push.TokenResult) {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		job.Processed++
+		if r.Success {
+			job.SuccessCount++
+		} else {
+			job.FailureCount++
+		}
+		job.Results = append(job.Results, r)
+	})
+	if spread := job.Request.GetOption().GetSpread(); spread > 0 {
+		// 均匀推送的任务由独立的 goroutine 按 Pacer 推送，不占用 worker
+		m.wg.Add(1)
+		go m.pace(ctx, job, time.Duration(spread)*time.Second)
+		return
+	}
+	resp, err := m.dispatcher.Push(ctx, job.Request)
+	m.finish(job, resp, err)
+}
+
+// finish 记录任务的推送结果并从队列中确认任务，推送结果保存失败时不确认任务，重启后重新推送
+func (m *Manager) finish(job *Job, resp *push.SendResponse, err error) {
+	id := job.ID
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.running, id)
+
+	job.FinishedAt = time.Now()
+	job.Status = StatusCompleted
+	if err != nil {
+		job.Status = StatusFailed
+		job.Error = err.Error()
+	}
+	if resp != nil {
+		// 以推送服务返回的结果为准，结果与请求中的 token 顺序一致
+		job.Results = resp.Results
+		job.Processed = len(resp.Results)
+		if job.Total == 0 {
+			// 按用户推送时在推送时才确定设备数量
+			job.Total = dispatcher.TokenCount(resp)
+		}
+		job.SuccessCount = resp.SuccessCount()
+		job.FailureCount = resp.FailureCount()
+		job.CappedCount = resp.CappedCount()
+		job.DeferredCount = resp.Deferred
+		job.TaskIDs = resp.TaskIDs
+		if len(job.TaskIDs) == 0 && resp.TaskId != "" {
+			job.TaskIDs = []string{resp.TaskId}
+		}
+	}
+	if err := m.save(job); err != nil {
+		m.logger.Error(err, "failed to save push job", "id", id)
+		return
+	}
+	if err := m.wal.Ack(id); err != nil {
+		m.logger.Error(err, "failed to ack push job", "id", id)
+	}
+	m.logger.Info("push job finished", "id", id, "status", job.Status, "success", job.SuccessCount, "failure", job.FailureCount, "capped", job.CappedCount, "deferred", job.DeferredCount)
+}
+
+// cleanup 定期删除超过保留时间的已结束任务
+func (m *Manager) cleanup() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			var expired []string
+			m.store.Range("", func(key string, value []byte) bool {
+				job := &Job{}
+				if err := json.Unmarshal(value, job); err != nil {
+					return true
+				}
+				if job.Finished() && time.Since(job.FinishedAt) > m.retention {
+					expired = append(expired, key)
+				}
+				return true
+			})
+			for _, key := range expired {
+				m.store.Del(key)
+			}
+		}
+	}
+}
+
+// priority 任务所在队列的优先级，无效的优先级使用 normal 队列
+func (j *Job) priority() string {
+	priority, err := lane.Normalize(j.Request.GetPriority())
+	if err != nil {
+		return lane.PriorityNormal
+	}
+	return priority
+}
+
+// reset 清除任务的推送进度，重新推送前调用，均匀推送的任务保留已推送的设备的进度
+func (j *Job) reset() {
+	j.Status = StatusQueued
+	if j.Offset > 0 {
+		return
+	}
+	j.Processed = 0
+	j.SuccessCount = 0
+	j.FailureCount = 0
+	j.CappedCount = 0
+	j.DeferredCount = 0
+	j.TaskIDs = nil
+	j.Error = ""
+	j.Results = nil
+	j.StartedAt = time.Time{}
+	j.FinishedAt = time.Time{}
+}
+
+func (m *Manager) load(id string) (*Job, error) {
+	value, ok := m.store.Get(id)
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	job := &Job{}
+	if err := json.Unmarshal(value, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (m *Manager) save(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return m.store.Set(job.ID, data)
+}
+
+// PushJob 转换为 pb 结构
+func (j *Job) PushJob() *v1.PushJob {
+	job := &v1.PushJob{
+		ID:            j.ID,
+		Status:        j.Status,
+		Platform:      j.Request.GetPlatform(),
+		AppID:         j.Request.GetAppID(),
+		AppName:       j.Request.GetAppName(),
+		Total:         int32(j.Total),
+		Processed:     int32(j.Processed),
+		SuccessCount:  int32(j.SuccessCount),
+		FailureCount:  int32(j.FailureCount),
+		CappedCount:   int32(j.CappedCount),
+		DeferredCount: int32(j.DeferredCount),
+		TaskIDs:       j.TaskIDs,
+		Error:         j.Error,
+		CreatedAt:     j.CreatedAt.Unix(),
+	}
+	if !j.StartedAt.IsZero() {
+		job.StartedAt = j.StartedAt.Unix()
+	}
+	if !j.FinishedAt.IsZero() {
+		job.FinishedAt = j.FinishedAt.Unix()
+	}
+	if j.ProjectedAt != nil {
+		job.ProjectedCompletionAt = j.ProjectedAt.Unix()
+	}
+	for _, r := range j.Results {
+		job.Results = append(job.Results, &v1.TokenResult{
+			Token:         r.Token,
+			Success:       r.Success,
+			Code:          r.Code,
+			Msg:           r.Msg,
+			MessageID:     r.MessageID,
+			Attempts:      int32(r.Attempts),
+			InvalidReason: r.InvalidReason,
+			Capped:        r.Capped,
+		})
+	}
+	return job
+}
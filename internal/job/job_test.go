@@ -0,0 +1,280 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/internal/factory"
+	"github.com/cossim/hipush/internal/lane"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-logr/logr"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubService 记录每次推送的设备，所有设备都推送成功
+type stubService struct {
+	mutex   sync.Mutex
+	batches [][]string
+}
+
+func (s *stubService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.batches = append(s.batches, req.GetToken())
+	resp := &push.SendResponse{TaskId: "task"}
+	for _, token := range req.GetToken() {
+		resp.Results = append(resp.Results, push.TokenResult{Token: token, Success: true})
+	}
+	return resp, nil
+}
+
+func (s *stubService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	return s.Send(ctx, req)
+}
+
+func (s *stubService) GetTasksStatus(ctx context.Context, appid string, taskID []string, obj push.TaskObjectList) error {
+	return nil
+}
+
+func (s *stubService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) Name() string {
+	return "ios"
+}
+
+func (s *stubService) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	return nil, nil
+}
+
+func (s *stubService) sent() [][]string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([][]string(nil), s.batches...)
+}
+
+// newTestManager 创建使用 dir 下的文件存储及持久化队列的任务管理器，normal 队列只能容纳一个任务
+func newTestManager(t *testing.T, dir string, service *stubService) *Manager {
+	f := factory.NewPushServiceFactory()
+	if err := f.Register(f.WithPushService(service)); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	q, err := store.NewFileQueue(filepath.Join(dir, "push_jobs.wal"), "")
+	if err != nil {
+		t.Fatalf("NewFileQueue() error = %v", err)
+	}
+	lanes := lane.New(map[string]config.LaneConfig{lane.PriorityNormal: {Concurrency: 1, QueueSize: 1}})
+	return NewManager(store.NewFileObjectStore(filepath.Join(dir, "push_jobs.json")), q, dispatcher.New(f), lanes, config.AsyncConfig{}, logr.Discard())
+}
+
+// waitJob 等待任务结束
+func waitJob(t *testing.T, m *Manager, id string) *Job {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := m.Get(id)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if job.Finished() {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job status = %s, want finished", job.Status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestManagerSubmit 测试提交任务、查询任务及推送结果
+func TestManagerSubmit(t *testing.T) {
+	service := &stubService{}
+	m := newTestManager(t, t.TempDir(), service)
+	if err := m.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer m.Close()
+
+	job, err := m.Submit(&v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	if job.Status != StatusQueued || job.Total != 2 {
+		t.Errorf("Submit() = %+v", job)
+	}
+
+	job = waitJob(t, m, job.ID)
+	if job.Status != StatusCompleted || job.SuccessCount != 2 || job.Processed != 2 || !reflect.DeepEqual(job.TaskIDs, []string{"task"}) {
+		t.Errorf("finished job = %+v", job)
+	}
+	if len(m.wal.Pending()) != 0 {
+		t.Errorf("finished job is not acked: %+v", m.wal.Pending())
+	}
+	// Test jobs without spread omit projected_at
+	if data, err := json.Marshal(job); err != nil || strings.Contains(string(data), "projected_at") {
+		t.Errorf("Marshal() = %s, %v, want no projected_at", data, err)
+	}
+
+	if _, err := m.Submit(&v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}, Priority: "urgent"}); !errors.Is(err, lane.ErrInvalidPriority) {
+		t.Errorf("Submit() invalid priority error = %v, want %v", err, lane.ErrInvalidPriority)
+	}
+	if _, err := m.Submit(&v1.PushRequest{Platform: "ios", AppID: "app"}); !errors.Is(err, dispatcher.ErrTokenRequired) {
+		t.Errorf("Submit() without token error = %v, want %v", err, dispatcher.ErrTokenRequired)
+	}
+	if _, err := m.Get("unknown"); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Get() unknown job error = %v, want %v", err, ErrJobNotFound)
+	}
+
+	var disabled *Manager
+	if _, err := disabled.Submit(&v1.PushRequest{}); !errors.Is(err, ErrJobsDisabled) {
+		t.Errorf("Submit() disabled error = %v, want %v", err, ErrJobsDisabled)
+	}
+}
+
+// TestManagerRestart 测试队列已满时拒绝任务，重启后重新推送未确认的任务，并将不在队列中的未结束任务标记为失败
+func TestManagerRestart(t *testing.T) {
+	dir := t.TempDir()
+	m := newTestManager(t, dir, &stubService{})
+	// 只初始化存储不启动 worker，任务停留在队列中
+	if err := m.store.Init(); err != nil {
+		t.Fatalf("store.Init() error = %v", err)
+	}
+	if err := m.wal.Init(); err != nil {
+		t.Fatalf("wal.Init() error = %v", err)
+	}
+
+	pending, err := m.Submit(&v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	// Test a full queue rejects the job without keeping it
+	if _, err := m.Submit(&v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"c"}}); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("Submit() full queue error = %v, want %v", err, ErrQueueFull)
+	}
+	if n := len(m.wal.Pending()); n != 1 {
+		t.Errorf("rejected job is kept in queue, pending = %d", n)
+	}
+
+	// 推送中的任务已开始推送，重启后重新推送
+	pending.Status = StatusRunning
+	pending.StartedAt = time.Now()
+	pending.Processed = 1
+	if err := m.save(pending); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+	// 队列中没有的未结束任务无法恢复
+	lost := &Job{ID: "lost", Status: StatusRunning, Request: &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"d"}}, Total: 1, CreatedAt: time.Now()}
+	if err := m.save(lost); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	service := &stubService{}
+	m = newTestManager(t, dir, service)
+	if err := m.Start(); err != nil {
+		t.Fatalf("Start() after restart error = %v", err)
+	}
+
+	job := waitJob(t, m, pending.ID)
+	if job.Status != StatusCompleted || job.SuccessCount != 2 || job.Processed != 2 {
+		t.Errorf("replayed job = %+v", job)
+	}
+	if want := [][]string{{"a", "b"}}; !reflect.DeepEqual(service.sent(), want) {
+		t.Errorf("sent = %v, want %v", service.sent(), want)
+	}
+	job, err = m.Get(lost.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if job.Status != StatusFailed || job.Error != "interrupted by server restart" || job.FinishedAt.IsZero() {
+		t.Errorf("interrupted job = %+v", job)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Test finished jobs are not pushed again after another restart
+	service = &stubService{}
+	m = newTestManager(t, dir, service)
+	if err := m.Start(); err != nil {
+		t.Fatalf("Start() after restart error = %v", err)
+	}
+	defer m.Close()
+	if job, err := m.Get(pending.ID); err != nil || job.Status != StatusCompleted {
+		t.Errorf("Get() after restart = %+v, %v", job, err)
+	}
+	if len(service.sent()) != 0 {
+		t.Errorf("finished job is pushed again: %v", service.sent())
+	}
+}
+
+// failingStore 任务结束后写入失败的存储
+type failingStore struct {
+	store.ObjectStore
+}
+
+func (s *failingStore) Set(key string, value []byte) error {
+	job := &Job{}
+	if err := json.Unmarshal(value, job); err == nil && job.Finished() {
+		return errors.New("disk full")
+	}
+	return s.ObjectStore.Set(key, value)
+}
+
+// finished 任务已推送且 worker 已处理完推送结果
+func finished(m *Manager, service *stubService) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(service.sent()) > 0 && len(m.running) == 0
+}
+
+// TestManagerFinishSaveError 测试推送结果保存失败时不确认任务
+func TestManagerFinishSaveError(t *testing.T) {
+	dir := t.TempDir()
+	service := &stubService{}
+	m := newTestManager(t, dir, service)
+	m.store = &failingStore{ObjectStore: m.store}
+	if err := m.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer m.Close()
+
+	job, err := m.Submit(&v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}})
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !finished(m, service) {
+		if time.Now().After(deadline) {
+			t.Fatalf("job is not finished")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if pending := m.wal.Pending(); len(pending) != 1 || pending[0].ID != job.ID {
+		t.Errorf("job with unsaved result is acked, pending = %+v", pending)
+	}
+}
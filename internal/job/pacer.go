@@ -0,0 +1,83 @@
+package job
+
+import (
+	"time"
+)
+
+// spreadTick 均匀推送时相邻两批之间的最小间隔
+const spreadTick = time.Second
+
+// Pacer 将设备在指定的时长内均匀地分成多批推送，每批的开始时间间隔相同，
+// 厂商接口的 QPS 限制不足以在间隔内推送完一批设备时按 QPS 延长间隔
+type Pacer struct {
+	start     time.Time
+	total     int
+	batchSize int
+	batches   int
+	interval  time.Duration
+}
+
+// NewPacer 创建从 start 开始在 spread 内推送 total 个设备的 Pacer，qps 为厂商接口的 QPS 限制，为 0 时不限制，
+// perCall 为每次调用厂商接口推送的设备数量，不大于 0 时表示每批只调用一次厂商接口
+func NewPacer(start time.Time, total int, spread time.Duration, qps float64, perCall int) *Pacer {
+	if total < 1 {
+		total = 1
+	}
+	batches := int(spread / spreadTick)
+	if batches > total {
+		batches = total
+	}
+	if batches < 1 {
+		batches = 1
+	}
+	batchSize := (total + batches - 1) / batches
+	batches = (total + batchSize - 1) / batchSize
+
+	var interval time.Duration
+	if batches > 1 {
+		interval = spread / time.Duration(batches)
+	}
+	if qps > 0 {
+		calls := 1
+		if perCall > 0 {
+			calls = (batchSize + perCall - 1) / perCall
+		}
+		if d := time.Duration(float64(calls) / qps * float64(time.Second)); d > interval {
+			interval = d
+		}
+	}
+	return &Pacer{start: start, total: total, batchSize: batchSize, batches: batches, interval: interval}
+}
+
+// Batches 批次数量
+func (p *Pacer) Batches() int {
+	return p.batches
+}
+
+// Batch 第 i 批设备的起止位置
+func (p *Pacer) Batch(i int) (int, int) {
+	from := i * p.batchSize
+	to := from + p.batchSize
+	if to > p.total {
+		to = p.total
+	}
+	return from, to
+}
+
+// Due 第 i 批设备的推送时间
+func (p *Pacer) Due(i int) time.Time {
+	return p.start.Add(time.Duration(i) * p.interval)
+}
+
+// Projected 前 done 批设备在 now 推送完后，按间隔推送剩余的设备预计完成的时间，
+// 推送落后于计划时从 now 开始计算
+func (p *Pacer) Projected(done int, now time.Time) time.Time {
+	if done >= p.batches {
+		return now
+	}
+	next := p.Due(done)
+	if now.After(next) {
+		next = now
+	}
+	return next.Add(time.Duration(p.batches-done) * p.interval)
+}
@@ -0,0 +1,44 @@
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPacer 测试设备在时长内均匀分批、QPS 限制不足时延长间隔以及预计完成时间
+func TestPacer(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+
+	p := NewPacer(start, 1000, 10*time.Second, 0, 1)
+	if p.Batches() != 10 {
+		t.Fatalf("Batches() = %d, want 10", p.Batches())
+	}
+	if from, to := p.Batch(9); from != 900 || to != 1000 {
+		t.Errorf("Batch(9) = %d, %d, want 900, 1000", from, to)
+	}
+	if due := p.Due(3); !due.Equal(start.Add(3 * time.Second)) {
+		t.Errorf("Due(3) = %v, want %v", due, start.Add(3*time.Second))
+	}
+	if at := p.Projected(0, start); !at.Equal(start.Add(10 * time.Second)) {
+		t.Errorf("Projected(0) = %v, want %v", at, start.Add(10*time.Second))
+	}
+	// 落后于计划时从当前时间开始计算剩余的批次
+	if at := p.Projected(5, start.Add(8*time.Second)); !at.Equal(start.Add(13 * time.Second)) {
+		t.Errorf("Projected(5) behind schedule = %v, want %v", at, start.Add(13*time.Second))
+	}
+
+	// 每批 100 个设备，每秒只能调用 50 次厂商接口，间隔延长为 2 秒
+	p = NewPacer(start, 1000, 10*time.Second, 50, 1)
+	if due := p.Due(1); !due.Equal(start.Add(2 * time.Second)) {
+		t.Errorf("Due(1) with qps = %v, want %v", due, start.Add(2*time.Second))
+	}
+	if at := p.Projected(0, start); !at.Equal(start.Add(20 * time.Second)) {
+		t.Errorf("Projected(0) with qps = %v, want %v", at, start.Add(20*time.Second))
+	}
+
+	// 设备数量少于秒数时每批一个设备
+	p = NewPacer(start, 3, time.Hour, 0, 1)
+	if p.Batches() != 3 || p.Due(1) != start.Add(20*time.Minute) {
+		t.Errorf("Batches() = %d, Due(1) = %v, want 3, %v", p.Batches(), p.Due(1), start.Add(20*time.Minute))
+	}
+}
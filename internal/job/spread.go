@@ -0,0 +1,151 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/ratelimit"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// ErrInvalidSpread 均匀推送的时长错误或推送请求不支持均匀推送
+var ErrInvalidSpread = errors.New("invalid spread")
+
+// CheckSpread 校验均匀推送的选项，按用户或分群推送时在推送时才确定设备，不支持均匀推送
+func CheckSpread(req *v1.PushRequest) error {
+	spread := req.GetOption().GetSpread()
+	if spread < 0 {
+		return fmt.Errorf("%w: spread must not be negative", ErrInvalidSpread)
+	}
+	if spread > 0 && dispatcher.UsesRegistry(req) {
+		return fmt.Errorf("%w: user_ids and segment are not supported", ErrInvalidSpread)
+	}
+	return nil
+}
+
+// pace 按 Pacer 推送均匀推送的任务，服务停止时任务不从队列中确认，重启后从保存的进度继续推送剩余的设备
+func (m *Manager) pace(ctx context.Context, job *Job, spread time.Duration) {
+	defer m.wg.Done()
+
+	resp, ok, err := m.spread(ctx, job, spread)
+	if !ok {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		delete(m.running, job.ID)
+		if err := m.save(job); err != nil {
+			m.logger.Error(err, "failed to save push job", "id", job.ID)
+		}
+		m.logger.Info("push job interrupted, remaining tokens will be sent after restart", "id", job.ID, "offset", job.Offset, "total", job.Total)
+		return
+	}
+	m.finish(job, resp, err)
+}
+
+// spread 从任务已推送的位置开始，将剩余的设备在剩余的时长内分批推送，每批推送后保存任务的进度及预计完成时间，
+// 一批设备全部推送失败时不再推送剩余的设备。服务停止时返回 false
+func (m *Manager) spread(ctx context.Context, job *Job, spread time.Duration) (*push.SendResponse, bool, error) {
+	req := job.Request
+	tokens := req.GetToken()
+
+	m.mutex.Lock()
+	remaining := tokens[job.Offset:]
+	m.mutex.Unlock()
+
+	duration := spread * time.Duration(len(remaining)) / time.Duration(len(tokens))
+	pacer := NewPacer(time.Now(), len(remaining), duration, ratelimit.PushLimiter.QPS(req.GetPlatform(), req.GetAppID()), perCall(req))
+	m.setProjected(job, pacer.Projected(0, time.Now()))
+
+	var errs []error
+	for i := 0; i < pacer.Batches() && len(remaining) > 0; i++ {
+		if wait := time.Until(pacer.Due(i)); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-m.stop:
+				timer.Stop()
+				return nil, false, nil
+			case <-timer.C:
+			}
+		}
+
+		from, to := pacer.Batch(i)
+		r := proto.Clone(req).(*v1.PushRequest)
+		r.Token = remaining[from:to]
+
+		m.mutex.Lock()
+		base := len(job.Results)
+		m.mutex.Unlock()
+		resp, err := m.dispatcher.Push(ctx, r)
+
+		m.mutex.Lock()
+		if resp != nil {
+			// 以推送服务返回的结果替换推送过程中回调的结果，回调时超过上限的设备计入失败数量
+			success, failure, capped := countResults(job.Results[base:])
+			job.SuccessCount -= success
+			job.FailureCount -= failure + capped
+			success, failure, capped = countResults(resp.Results)
+			job.SuccessCount += success
+			job.FailureCount += failure
+			job.CappedCount += capped
+			job.Results = append(job.Results[:base], resp.Results...)
+			job.Processed = len(job.Results)
+			job.DeferredCount += resp.Deferred
+			if len(resp.TaskIDs) > 0 {
+				job.TaskIDs = append(job.TaskIDs, resp.TaskIDs...)
+			} else if resp.TaskId != "" {
+				job.TaskIDs = append(job.TaskIDs, resp.TaskId)
+			}
+			job.Offset += len(r.Token)
+		}
+		projected := pacer.Projected(i+1, time.Now())
+		job.ProjectedAt = &projected
+		// 每批推送后保存进度，服务异常退出后只重新推送正在推送的一批设备
+		if err := m.save(job); err != nil {
+			m.logger.Error(err, "failed to save push job", "id", job.ID)
+		}
+		m.mutex.Unlock()
+
+		if err != nil {
+			errs = append(errs, err)
+			if resp == nil {
+				break
+			}
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return &push.SendResponse{Results: job.Results, Deferred: job.DeferredCount, TaskIDs: job.TaskIDs}, true, errors.Join(errs...)
+}
+
+func (m *Manager) setProjected(job *Job, at time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	job.ProjectedAt = &at
+}
+
+// perCall 每次调用厂商接口推送的设备数量，单推时每个设备调用一次，批量推送时为 0 表示每批调用一次
+func perCall(req *v1.PushRequest) int {
+	if !req.GetOption().GetMulticast() {
+		return 1
+	}
+	return int(req.GetOption().GetMaxDevices())
+}
+
+// countResults 统计推送成功、失败及超过推送频率上限的设备数量，失败数量不包括超过上限的设备
+func countResults(results []push.TokenResult) (success, failure, capped int) {
+	for _, r := range results {
+		switch {
+		case r.Success:
+			success++
+		case r.Capped:
+			capped++
+		default:
+			failure++
+		}
+	}
+	return success, failure, capped
+}
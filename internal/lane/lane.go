@@ -0,0 +1,111 @@
+package lane
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/config"
+)
+
+// PushLanes 按优先级划分的推送通道
+var PushLanes *Lanes
+
+// 推送优先级
+const (
+	PriorityHigh   = "high"
+	PriorityNormal = "normal"
+	PriorityLow    = "low"
+)
+
+// Priorities 所有的推送优先级，从高到低
+var Priorities = []string{PriorityHigh, PriorityNormal, PriorityLow}
+
+var ErrInvalidPriority = errors.New("invalid priority, must be one of high, normal, low")
+
+var defaultLanes = map[string]config.LaneConfig{
+	PriorityHigh:   {Concurrency: 100, QueueSize: 1000},
+	PriorityNormal: {Concurrency: 50, QueueSize: 1000},
+	PriorityLow:    {Concurrency: 10, QueueSize: 10000},
+}
+
+func InitPushLanes(cfg *config.Config) {
+	PushLanes = New(cfg.Lanes)
+}
+
+// Lane 推送通道，同一通道内同时推送的请求数量不超过 Concurrency
+type Lane struct {
+	Name      string
+	Capacity  int
+	QueueSize int
+	sem       chan struct{}
+}
+
+// Lanes 每个优先级使用独立的并发额度，低优先级的批量推送不会占用高优先级推送的额度
+type Lanes struct {
+	lanes map[string]*Lane
+}
+
+// New 创建推送通道，未配置或配置为 0 的字段使用默认值
+func New(cfg map[string]config.LaneConfig) *Lanes {
+	l := &Lanes{lanes: make(map[string]*Lane, len(Priorities))}
+	for _, priority := range Priorities {
+		c := defaultLanes[priority]
+		if v, ok := cfg[priority]; ok {
+			if v.Concurrency > 0 {
+				c.Concurrency = v.Concurrency
+			}
+			if v.QueueSize > 0 {
+				c.QueueSize = v.QueueSize
+			}
+		}
+		l.lanes[priority] = &Lane{
+			Name:      priority,
+			Capacity:  c.Concurrency,
+			QueueSize: c.QueueSize,
+			sem:       make(chan struct{}, c.Concurrency),
+		}
+	}
+	return l
+}
+
+// Normalize 校验推送优先级，为空时返回 PriorityNormal
+func Normalize(priority string) (string, error) {
+	switch priority {
+	case "":
+		return PriorityNormal, nil
+	case PriorityHigh, PriorityNormal, PriorityLow:
+		return priority, nil
+	default:
+		return "", ErrInvalidPriority
+	}
+}
+
+// Get 获取优先级对应的推送通道
+func (l *Lanes) Get(priority string) (*Lane, error) {
+	priority, err := Normalize(priority)
+	if err != nil {
+		return nil, err
+	}
+	return l.lanes[priority], nil
+}
+
+// Acquire 占用优先级对应通道的一个并发额度，通道已满时等待直到 ctx 结束，
+// 推送结束后需要调用返回的 release 释放额度
+func (l *Lanes) Acquire(ctx context.Context, priority string) (release func(), err error) {
+	if l == nil {
+		if _, err := Normalize(priority); err != nil {
+			return nil, err
+		}
+		return func() {}, nil
+	}
+
+	lane, err := l.Get(priority)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case lane.sem <- struct{}{}:
+		return func() { <-lane.sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,46 @@
+package lane
+
+import (
+	"context"
+	"github.com/cossim/hipush/config"
+	"testing"
+	"time"
+)
+
+func TestLanes(t *testing.T) {
+	lanes := New(map[string]config.LaneConfig{
+		PriorityHigh: {Concurrency: 1},
+		PriorityLow:  {Concurrency: 1},
+	})
+
+	// Test a full low lane does not block the high lane
+	release, err := lanes.Acquire(context.Background(), PriorityLow)
+	if err != nil {
+		t.Fatalf("Acquire failed: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := lanes.Acquire(ctx, PriorityLow); err == nil {
+		t.Errorf("Acquire failed: expected low lane to be full")
+	}
+	releaseHigh, err := lanes.Acquire(context.Background(), PriorityHigh)
+	if err != nil {
+		t.Errorf("Acquire failed: expected high lane to be free but got %v", err)
+	} else {
+		releaseHigh()
+	}
+	release()
+	if release, err := lanes.Acquire(context.Background(), PriorityLow); err != nil {
+		t.Errorf("Acquire failed: expected low lane to be released but got %v", err)
+	} else {
+		release()
+	}
+
+	// Test default priority and invalid priority
+	if lane, err := lanes.Get(""); err != nil || lane.Name != PriorityNormal || lane.Capacity != 50 {
+		t.Errorf("Get failed: expected default normal lane but got %+v, %v", lane, err)
+	}
+	if _, err := lanes.Acquire(context.Background(), "urgent"); err != ErrInvalidPriority {
+		t.Errorf("Acquire failed: expected ErrInvalidPriority but got %v", err)
+	}
+}
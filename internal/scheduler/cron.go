@@ -0,0 +1,373 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-co-op/gocron/v2"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PushCronScheduler 周期推送调度器
+var PushCronScheduler *CronScheduler
+
+var (
+	ErrCronJobNotFound = errors.New("cron job not found")
+	ErrInvalidTimezone = errors.New("invalid timezone")
+)
+
+// maxCronHistory 每个周期任务保留的推送记录数量
+const maxCronHistory = 20
+
+func InitPushCronScheduler(cfg *config.Config, dispatcher *dispatcher.Dispatcher, logger logr.Logger) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "cron_jobs")
+	if err != nil {
+		return err
+	}
+
+	PushCronScheduler, err = NewCronScheduler(s, dispatcher, logger)
+	if err != nil {
+		return err
+	}
+	return PushCronScheduler.Start()
+}
+
+// CronJob 周期推送任务
+type CronJob struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Cron      string          `json:"cron"`
+	Timezone  string          `json:"timezone"`
+	Request   *v1.PushRequest `json:"request"`
+	CreatedAt time.Time       `json:"created_at"`
+	// History 最近的推送记录，最新的在前
+	History []*CronRun `json:"history"`
+
+	nextRun time.Time
+}
+
+// CronRun 周期任务的一次推送记录
+type CronRun struct {
+	StartedAt    time.Time `json:"started_at"`
+	FinishedAt   time.Time `json:"finished_at"`
+	Success      bool      `json:"success"`
+	Error        string    `json:"error,omitempty"`
+	TaskIDs      []string  `json:"task_ids,omitempty"`
+	SuccessCount int       `json:"success_count"`
+	FailureCount int       `json:"failure_count"`
+}
+
+// CronScheduler 将周期推送任务保存在 store 中，重启后会重新注册所有任务，
+// 每次推送的结果会记录在任务的 History 中
+type CronScheduler struct {
+	store      store.ObjectStore
+	dispatcher *dispatcher.Dispatcher
+	cron       gocron.Scheduler
+	logger     logr.Logger
+
+	// mutex 保护 jobs 以及 store 中任务的读改写
+	mutex sync.Mutex
+	jobs  map[string]gocron.Job
+}
+
+func NewCronScheduler(store store.ObjectStore, dispatcher *dispatcher.Dispatcher, logger logr.Logger) (*CronScheduler, error) {
+	cron, err := gocron.NewScheduler()
+	if err != nil {
+		return nil, err
+	}
+	return &CronScheduler{
+		store:      store,
+		dispatcher: dispatcher,
+		cron:       cron,
+		logger:     logger.WithValues("component", "cron"),
+		jobs:       make(map[string]gocron.Job),
+	}, nil
+}
+
+func (s *CronScheduler) Start() error {
+	if err := s.store.Init(); err != nil {
+		return err
+	}
+
+	var jobs []*CronJob
+	s.store.Range("", func(_ string, value []byte) bool {
+		job := &CronJob{}
+		if err := json.Unmarshal(value, job); err != nil {
+			s.logger.Error(err, "failed to unmarshal cron job")
+			return true
+		}
+		jobs = append(jobs, job)
+		return true
+	})
+	for _, job := range jobs {
+		if err := s.schedule(job); err != nil {
+			s.logger.Error(err, "failed to reschedule cron job", "id", job.ID)
+		}
+	}
+
+	s.cron.Start()
+	s.logger.Info("cron scheduler started", "jobs", len(jobs))
+	return nil
+}
+
+func (s *CronScheduler) Close() error {
+	if s == nil {
+		return nil
+	}
+	if err := s.cron.Shutdown(); err != nil {
+		s.logger.Error(err, "failed to shutdown cron scheduler")
+	}
+	return s.store.Close()
+}
+
+// Add 校验 cron 表达式、时区以及推送请求后保存周期推送任务
+func (s *CronScheduler) Add(ctx context.Context, name, cron, timezone string, req *v1.PushRequest) (*CronJob, error) {
+	if s == nil {
+		return nil, ErrSchedulerDisabled
+	}
+	if req == nil {
+		return nil, errors.New("request is required")
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTimezone, timezone)
+	}
+	if err := s.dispatcher.Validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	job := &CronJob{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Cron:      strings.TrimSpace(cron),
+		Timezone:  timezone,
+		Request:   req,
+		CreatedAt: time.Now(),
+	}
+	// 先保存任务再注册，注册时校验 cron 表达式，校验失败时删除已保存的任务
+	if err := s.save(job); err != nil {
+		return nil, err
+	}
+	if err := s.schedule(job); err != nil {
+		s.store.Del(job.ID)
+		return nil, err
+	}
+
+	s.logger.Info("cron job added", "id", job.ID, "name", name, "cron", job.Cron, "timezone", timezone)
+	return s.Get(job.ID)
+}
+
+// Get 查询周期推送任务及其推送记录
+func (s *CronScheduler) Get(id string) (*CronJob, error) {
+	if s == nil {
+		return nil, ErrSchedulerDisabled
+	}
+
+	value, ok := s.store.Get(id)
+	if !ok {
+		return nil, ErrCronJobNotFound
+	}
+	job := &CronJob{}
+	if err := json.Unmarshal(value, job); err != nil {
+		return nil, err
+	}
+	job.nextRun = s.nextRun(id)
+	return job, nil
+}
+
+// List 查询周期推送任务，platform 和 appID 为空时不进行过滤
+func (s *CronScheduler) List(platform, appID string) []*CronJob {
+	if s == nil {
+		return nil
+	}
+
+	var jobs []*CronJob
+	s.store.Range("", func(_ string, value []byte) bool {
+		job := &CronJob{}
+		if err := json.Unmarshal(value, job); err != nil {
+			return true
+		}
+		if platform != "" && job.Request.GetPlatform() != platform {
+			return true
+		}
+		if appID != "" && job.Request.GetAppID() != appID {
+			return true
+		}
+		job.nextRun = s.nextRun(job.ID)
+		jobs = append(jobs, job)
+		return true
+	})
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt)
+	})
+	return jobs
+}
+
+// Delete 删除周期推送任务，正在执行的推送不受影响，但不会再记录其结果
+func (s *CronScheduler) Delete(id string) error {
+	if s == nil {
+		return ErrSchedulerDisabled
+	}
+	if !s.remove(id) {
+		return ErrCronJobNotFound
+	}
+	s.logger.Info("cron job deleted", "id", id)
+	return s.store.Del(id)
+}
+
+func (s *CronScheduler) schedule(job *CronJob) error {
+	crontab := job.Cron
+	if job.Timezone != "" {
+		crontab = "CRON_TZ=" + job.Timezone + " " + crontab
+	}
+	// 6 位表达式的第一位为秒
+	withSeconds := len(strings.Fields(job.Cron)) == 6
+
+	j, err := s.cron.NewJob(
+		gocron.CronJob(crontab, withSeconds),
+		gocron.NewTask(s.run, job.ID),
+		gocron.WithName(job.ID),
+		// 上一次推送未结束时跳过本次推送
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	s.jobs[job.ID] = j
+	s.mutex.Unlock()
+	return nil
+}
+
+func (s *CronScheduler) remove(id string) bool {
+	s.mutex.Lock()
+	j, ok := s.jobs[id]
+	delete(s.jobs, id)
+	s.mutex.Unlock()
+	if !ok {
+		return false
+	}
+
+	if err := s.cron.RemoveJob(j.ID()); err != nil && !errors.Is(err, gocron.ErrJobNotFound) {
+		s.logger.Error(err, "failed to remove cron job", "id", id)
+	}
+	return true
+}
+
+func (s *CronScheduler) nextRun(id string) time.Time {
+	s.mutex.Lock()
+	j, ok := s.jobs[id]
+	s.mutex.Unlock()
+	if !ok {
+		return time.Time{}
+	}
+	t, err := j.NextRun()
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func (s *CronScheduler) save(job *CronJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.store.Set(job.ID, data)
+}
+
+func (s *CronScheduler) run(id string) {
+	job, err := s.Get(id)
+	if err != nil {
+		s.logger.Error(err, "failed to load cron job", "id", id)
+		return
+	}
+
+	run := &CronRun{StartedAt: time.Now()}
+	resp, err := s.dispatcher.Push(context.Background(), job.Request)
+	run.FinishedAt = time.Now()
+	if err != nil {
+		run.Error = err.Error()
+	}
+	if resp != nil {
+		run.Success = resp.SuccessCount() > 0
+		run.SuccessCount = resp.SuccessCount()
+		run.FailureCount = resp.FailureCount()
+		run.TaskIDs = resp.TaskIDs
+		if len(run.TaskIDs) == 0 && resp.TaskId != "" {
+			run.TaskIDs = []string{resp.TaskId}
+		}
+	}
+
+	if err != nil {
+		s.logger.Error(err, "failed to send cron push", "id", id)
+	} else {
+		s.logger.Info("cron push sent", "id", id, "success", run.SuccessCount, "failure", run.FailureCount)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.jobs[id]; !ok {
+		// 推送期间任务已被删除
+		return
+	}
+	// 重新读取任务，避免覆盖推送期间的修改
+	value, ok := s.store.Get(id)
+	if !ok {
+		return
+	}
+	job = &CronJob{}
+	if err := json.Unmarshal(value, job); err != nil {
+		s.logger.Error(err, "failed to unmarshal cron job", "id", id)
+		return
+	}
+	job.History = append([]*CronRun{run}, job.History...)
+	if len(job.History) > maxCronHistory {
+		job.History = job.History[:maxCronHistory]
+	}
+	if err := s.save(job); err != nil {
+		s.logger.Error(err, "failed to save cron run", "id", id)
+	}
+}
+
+// CronJob 转换为 pb 结构
+func (j *CronJob) CronJob() *v1.CronJob {
+	job := &v1.CronJob{
+		ID:        j.ID,
+		Name:      j.Name,
+		Cron:      j.Cron,
+		Timezone:  j.Timezone,
+		Platform:  j.Request.GetPlatform(),
+		AppID:     j.Request.GetAppID(),
+		AppName:   j.Request.GetAppName(),
+		Token:     j.Request.GetToken(),
+		Data:      j.Request.GetData(),
+		Option:    j.Request.GetOption(),
+		CreatedAt: j.CreatedAt.Unix(),
+	}
+	if !j.nextRun.IsZero() {
+		job.NextRunAt = j.nextRun.Unix()
+	}
+	for _, run := range j.History {
+		job.History = append(job.History, &v1.CronRun{
+			StartedAt:    run.StartedAt.Unix(),
+			FinishedAt:   run.FinishedAt.Unix(),
+			Success:      run.Success,
+			Error:        run.Error,
+			TaskIDs:      run.TaskIDs,
+			SuccessCount: int32(run.SuccessCount),
+			FailureCount: int32(run.FailureCount),
+		})
+	}
+	return job
+}
@@ -0,0 +1,155 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-logr/logr"
+	"testing"
+	"time"
+)
+
+func newTestCronScheduler(t *testing.T, s store.ObjectStore, service *stubService) *CronScheduler {
+	scheduler, err := NewCronScheduler(s, newTestDispatcher(t, service), logr.Discard())
+	if err != nil {
+		t.Fatalf("NewCronScheduler() error = %v", err)
+	}
+	if err := scheduler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	return scheduler
+}
+
+// TestCronSchedulerAdd 测试添加周期任务时校验推送请求、cron 表达式及时区，校验失败时不保存任务
+func TestCronSchedulerAdd(t *testing.T) {
+	s := store.NewMemoryObjectStore()
+	scheduler := newTestCronScheduler(t, s, &stubService{})
+	defer scheduler.Close()
+
+	req := &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}}
+	tests := []struct {
+		name     string
+		cron     string
+		timezone string
+		req      *v1.PushRequest
+		want     error
+	}{
+		{"no request", "0 9 * * *", "", nil, nil},
+		{"invalid timezone", "0 9 * * *", "Mars/Base", req, ErrInvalidTimezone},
+		{"invalid CRON_TZ", "CRON_TZ=Mars/Base 0 9 * * *", "", req, nil},
+		{"invalid cron", "0 9 * *", "", req, nil},
+		{"unsupported platform", "0 9 * * *", "", &v1.PushRequest{Platform: "android", AppID: "app", Token: []string{"a"}}, nil},
+	}
+	for _, tt := range tests {
+		_, err := scheduler.Add(context.Background(), tt.name, tt.cron, tt.timezone, tt.req)
+		if err == nil || (tt.want != nil && !errors.Is(err, tt.want)) {
+			t.Errorf("%s: Add() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+	if jobs := scheduler.List("", ""); len(jobs) != 0 {
+		t.Fatalf("List() after invalid Add() = %v", jobs)
+	}
+	scheduler.mutex.Lock()
+	registered := len(scheduler.jobs)
+	scheduler.mutex.Unlock()
+	if registered != 0 {
+		t.Fatalf("invalid jobs are registered: %d", registered)
+	}
+
+	job, err := scheduler.Add(context.Background(), "daily", " 0 9 * * * ", "Asia/Shanghai", req)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if job.Cron != "0 9 * * *" || job.nextRun.IsZero() {
+		t.Errorf("Add() = %+v", job)
+	}
+	if jobs := scheduler.List("ios", "app"); len(jobs) != 1 || jobs[0].ID != job.ID {
+		t.Errorf("List() = %v", jobs)
+	}
+
+	if err := scheduler.Delete(job.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := scheduler.Get(job.ID); !errors.Is(err, ErrCronJobNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, ErrCronJobNotFound)
+	}
+	if err := scheduler.Delete(job.ID); !errors.Is(err, ErrCronJobNotFound) {
+		t.Errorf("Delete() twice error = %v, want %v", err, ErrCronJobNotFound)
+	}
+}
+
+// TestCronSchedulerHistory 测试推送记录按时间倒序保存，最多保留 maxCronHistory 条
+func TestCronSchedulerHistory(t *testing.T) {
+	service := &stubService{}
+	scheduler := newTestCronScheduler(t, store.NewMemoryObjectStore(), service)
+	defer scheduler.Close()
+
+	job, err := scheduler.Add(context.Background(), "yearly", "0 0 1 1 *", "", &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	for i := 0; i < maxCronHistory+5; i++ {
+		scheduler.run(job.ID)
+	}
+
+	job, err = scheduler.Get(job.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(job.History) != maxCronHistory {
+		t.Fatalf("History has %d runs, want %d", len(job.History), maxCronHistory)
+	}
+	for i, run := range job.History {
+		if !run.Success || run.SuccessCount != 1 || run.TaskIDs[0] != "task" {
+			t.Errorf("History[%d] = %+v", i, run)
+		}
+		if i > 0 && run.StartedAt.After(job.History[i-1].StartedAt) {
+			t.Errorf("History[%d] is newer than History[%d]", i, i-1)
+		}
+	}
+	if n := len(service.sent()); n != maxCronHistory+5 {
+		t.Errorf("sent %d pushes, want %d", n, maxCronHistory+5)
+	}
+}
+
+// TestCronSchedulerRestart 测试重启后重新注册保存的周期任务并按时推送
+func TestCronSchedulerRestart(t *testing.T) {
+	s := store.NewMemoryObjectStore()
+	first := newTestCronScheduler(t, s, &stubService{})
+	job, err := first.Add(context.Background(), "every second", "* * * * * *", "", &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := first.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	service := &stubService{}
+	second := newTestCronScheduler(t, s, service)
+	defer second.Close()
+
+	reloaded, err := second.Get(job.ID)
+	if err != nil {
+		t.Fatalf("Get() after restart error = %v", err)
+	}
+	if reloaded.nextRun.IsZero() {
+		t.Errorf("reloaded job is not scheduled")
+	}
+	waitSent(t, service, 1)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		reloaded, err = second.Get(job.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if len(reloaded.History) > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("cron run is not recorded after restart")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
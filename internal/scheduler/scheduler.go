@@ -0,0 +1,324 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
+	pushjob "github.com/cossim/hipush/internal/job"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-co-op/gocron/v2"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"sort"
+	"sync"
+	"time"
+)
+
+// PushScheduler 定时推送调度器
+var PushScheduler *Scheduler
+
+var (
+	ErrSchedulerDisabled = errors.New("push scheduler is not enabled")
+	ErrJobNotFound       = errors.New("scheduled push not found")
+)
+
+// submitRetryInterval 均匀推送的请求提交到异步推送任务失败时重新提交的间隔
+const submitRetryInterval = time.Minute
+
+// InitPushScheduler 创建定时推送调度器，调度器需要在异步推送任务初始化后调用 Start 启动，
+// 启动时已到推送时间的均匀推送请求会立即提交到异步推送任务
+func InitPushScheduler(cfg *config.Config, dispatcher *dispatcher.Dispatcher, logger logr.Logger) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "scheduled_pushes")
+	if err != nil {
+		return err
+	}
+
+	PushScheduler, err = NewScheduler(s, dispatcher, logger)
+	return err
+}
+
+// Job 定时推送任务
+type Job struct {
+	ID          string          `json:"id"`
+	Request     *v1.PushRequest `json:"request"`
+	ScheduledAt time.Time       `json:"scheduled_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// Scheduler 将定时推送任务保存在 store 中，重启后会重新加载未推送的任务，
+// 重启期间已到推送时间的任务会在启动后立即推送
+type Scheduler struct {
+	store      store.ObjectStore
+	dispatcher *dispatcher.Dispatcher
+	cron       gocron.Scheduler
+	logger     logr.Logger
+
+	mutex sync.Mutex
+	jobs  map[string]uuid.UUID
+}
+
+func NewScheduler(store store.ObjectStore, dispatcher *dispatcher.Dispatcher, logger logr.Logger) (*Scheduler, error) {
+	cron, err := gocron.NewScheduler()
+	if err != nil {
+		return nil, err
+	}
+	return &Scheduler{
+		store:      store,
+		dispatcher: dispatcher,
+		cron:       cron,
+		logger:     logger.WithValues("component", "scheduler"),
+		jobs:       make(map[string]uuid.UUID),
+	}, nil
+}
+
+func (s *Scheduler) Start() error {
+	if err := s.store.Init(); err != nil {
+		return err
+	}
+
+	var jobs []*Job
+	s.store.Range("", func(_ string, value []byte) bool {
+		job := &Job{}
+		if err := json.Unmarshal(value, job); err != nil {
+			s.logger.Error(err, "failed to unmarshal scheduled push")
+			return true
+		}
+		jobs = append(jobs, job)
+		return true
+	})
+	for _, job := range jobs {
+		if err := s.schedule(job); err != nil {
+			s.logger.Error(err, "failed to reschedule push", "id", job.ID)
+		}
+	}
+
+	s.cron.Start()
+	s.logger.Info("push scheduler started", "jobs", len(jobs))
+	return nil
+}
+
+func (s *Scheduler) Close() error {
+	if s == nil {
+		return nil
+	}
+	if err := s.cron.Shutdown(); err != nil {
+		s.logger.Error(err, "failed to shutdown scheduler")
+	}
+	return s.store.Close()
+}
+
+// Add 校验推送请求后保存定时推送任务
+func (s *Scheduler) Add(ctx context.Context, req *v1.PushRequest, at time.Time) (*Job, error) {
+	if s == nil {
+		return nil, ErrSchedulerDisabled
+	}
+
+	if err := s.dispatcher.Validate(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := pushjob.CheckSpread(req); err != nil {
+		return nil, err
+	}
+
+	job, err := s.add(req, at)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("push scheduled", "id", job.ID, "platform", req.Platform, "scheduled_at", at)
+	return job, nil
+}
+
+// Defer 保存不在投递时间窗口内、推迟到窗口开始时推送的请求，请求在推送前已经校验，不再重复校验
+func (s *Scheduler) Defer(req *v1.PushRequest, at time.Time) (string, error) {
+	if s == nil {
+		return "", ErrSchedulerDisabled
+	}
+
+	job, err := s.add(req, at)
+	if err != nil {
+		return "", err
+	}
+	s.logger.Info("push deferred to delivery window", "id", job.ID, "platform", req.Platform, "tokens", len(req.Token), "scheduled_at", at)
+	return job.ID, nil
+}
+
+func (s *Scheduler) add(req *v1.PushRequest, at time.Time) (*Job, error) {
+	job := &Job{
+		ID:          uuid.New().String(),
+		Request:     req,
+		ScheduledAt: at,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.save(job); err != nil {
+		return nil, err
+	}
+
+	if err := s.schedule(job); err != nil {
+		s.store.Del(job.ID)
+		return nil, err
+	}
+	return job, nil
+}
+
+// List 查询未推送的定时推送任务，platform 和 appID 为空时不进行过滤
+func (s *Scheduler) List(platform, appID string) []*Job {
+	if s == nil {
+		return nil
+	}
+
+	var jobs []*Job
+	s.store.Range("", func(_ string, value []byte) bool {
+		job := &Job{}
+		if err := json.Unmarshal(value, job); err != nil {
+			return true
+		}
+		if platform != "" && job.Request.GetPlatform() != platform {
+			return true
+		}
+		if appID != "" && job.Request.GetAppID() != appID {
+			return true
+		}
+		jobs = append(jobs, job)
+		return true
+	})
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ScheduledAt.Before(jobs[j].ScheduledAt)
+	})
+	return jobs
+}
+
+// Cancel 取消未推送的定时推送任务
+func (s *Scheduler) Cancel(id string) error {
+	if s == nil {
+		return ErrSchedulerDisabled
+	}
+
+	s.mutex.Lock()
+	jobID, ok := s.jobs[id]
+	delete(s.jobs, id)
+	s.mutex.Unlock()
+	if !ok {
+		return ErrJobNotFound
+	}
+
+	if err := s.cron.RemoveJob(jobID); err != nil && !errors.Is(err, gocron.ErrJobNotFound) {
+		return err
+	}
+	s.logger.Info("scheduled push cancelled", "id", id)
+	return s.store.Del(id)
+}
+
+func (s *Scheduler) schedule(job *Job) error {
+	startAt := gocron.OneTimeJobStartImmediately()
+	if job.ScheduledAt.After(time.Now()) {
+		startAt = gocron.OneTimeJobStartDateTime(job.ScheduledAt)
+	}
+
+	// 立即推送的任务可能在 NewJob 返回前开始执行，持有锁直到记录任务后 run 才能查询到任务
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	j, err := s.cron.NewJob(
+		gocron.OneTimeJob(startAt),
+		gocron.NewTask(s.run, job.ID),
+		gocron.WithName(job.ID),
+		gocron.WithLimitedRuns(1),
+	)
+	if err != nil {
+		return err
+	}
+	s.jobs[job.ID] = j.ID()
+	return nil
+}
+
+func (s *Scheduler) run(id string) {
+	s.mutex.Lock()
+	_, ok := s.jobs[id]
+	delete(s.jobs, id)
+	s.mutex.Unlock()
+	if !ok {
+		// 任务已被取消
+		return
+	}
+
+	value, ok := s.store.Get(id)
+	if !ok {
+		return
+	}
+	job := &Job{}
+	if err := json.Unmarshal(value, job); err != nil {
+		s.logger.Error(err, "failed to unmarshal scheduled push", "id", id)
+		s.store.Del(id)
+		return
+	}
+
+	if job.Request.GetOption().GetSpread() > 0 {
+		s.submit(job)
+		return
+	}
+
+	if err := s.store.Del(id); err != nil {
+		s.logger.Error(err, "failed to delete scheduled push", "id", id)
+	}
+	resp, err := s.dispatcher.Push(context.Background(), job.Request)
+	if err != nil {
+		s.logger.Error(err, "failed to send scheduled push", "id", id)
+		return
+	}
+	s.logger.Info("scheduled push sent", "id", id, "success", resp.SuccessCount(), "failure", resp.FailureCount(), "deferred", resp.Deferred)
+}
+
+// submit 将均匀推送的请求交给异步推送任务按时长分批推送，提交成功后才删除定时推送任务，
+// 异步推送任务未启用或队列已满时保留任务并在 submitRetryInterval 后重新提交
+func (s *Scheduler) submit(job *Job) {
+	j, err := pushjob.PushJobs.Submit(job.Request)
+	if err != nil {
+		if !errors.Is(err, pushjob.ErrJobsDisabled) && !errors.Is(err, pushjob.ErrQueueFull) {
+			s.logger.Error(err, "failed to submit scheduled spread push", "id", job.ID)
+			if err := s.store.Del(job.ID); err != nil {
+				s.logger.Error(err, "failed to delete scheduled push", "id", job.ID)
+			}
+			return
+		}
+
+		job.ScheduledAt = time.Now().Add(submitRetryInterval)
+		if err := s.save(job); err != nil {
+			s.logger.Error(err, "failed to save scheduled push", "id", job.ID)
+		}
+		if err := s.schedule(job); err != nil {
+			s.logger.Error(err, "failed to reschedule push", "id", job.ID)
+			return
+		}
+		s.logger.Error(err, "failed to submit scheduled spread push, retry later", "id", job.ID, "scheduled_at", job.ScheduledAt)
+		return
+	}
+
+	if err := s.store.Del(job.ID); err != nil {
+		s.logger.Error(err, "failed to delete scheduled push", "id", job.ID)
+	}
+	s.logger.Info("scheduled push submitted as spread push job", "id", job.ID, "job", j.ID)
+}
+
+func (s *Scheduler) save(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.store.Set(job.ID, data)
+}
+
+// ScheduledPush 转换为 pb 结构
+func (j *Job) ScheduledPush() *v1.ScheduledPush {
+	return &v1.ScheduledPush{
+		ID:          j.ID,
+		Platform:    j.Request.GetPlatform(),
+		AppID:       j.Request.GetAppID(),
+		AppName:     j.Request.GetAppName(),
+		Token:       j.Request.GetToken(),
+		Data:        j.Request.GetData(),
+		ScheduledAt: j.ScheduledAt.Unix(),
+		CreatedAt:   j.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,271 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/internal/factory"
+	pushjob "github.com/cossim/hipush/internal/job"
+	"github.com/cossim/hipush/internal/lane"
+	"github.com/cossim/hipush/pkg/store"
+	"github.com/go-logr/logr"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubService 记录每次推送的设备，不记录只校验数据的推送，所有设备都推送成功
+type stubService struct {
+	mutex   sync.Mutex
+	batches [][]string
+}
+
+func (s *stubService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if (&push.SendOptions{}).ApplyOptions(opt).DryRun {
+		return &push.SendResponse{}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.batches = append(s.batches, req.GetToken())
+	resp := &push.SendResponse{TaskId: "task"}
+	for _, token := range req.GetToken() {
+		resp.Results = append(resp.Results, push.TokenResult{Token: token, Success: true})
+	}
+	return resp, nil
+}
+
+func (s *stubService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	return s.Send(ctx, req)
+}
+
+func (s *stubService) GetTasksStatus(ctx context.Context, appid string, taskID []string, obj push.TaskObjectList) error {
+	return nil
+}
+
+func (s *stubService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) Name() string {
+	return "ios"
+}
+
+func (s *stubService) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	return nil, nil
+}
+
+func (s *stubService) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	return nil, nil
+}
+
+func (s *stubService) sent() [][]string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([][]string(nil), s.batches...)
+}
+
+func newTestDispatcher(t *testing.T, service *stubService) *dispatcher.Dispatcher {
+	f := factory.NewPushServiceFactory()
+	if err := f.Register(f.WithPushService(service)); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	return dispatcher.New(f)
+}
+
+func newTestScheduler(t *testing.T, s store.ObjectStore, service *stubService) *Scheduler {
+	scheduler, err := NewScheduler(s, newTestDispatcher(t, service), logr.Discard())
+	if err != nil {
+		t.Fatalf("NewScheduler() error = %v", err)
+	}
+	if err := scheduler.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	return scheduler
+}
+
+// waitSent 等待推送服务收到 n 次推送
+func waitSent(t *testing.T, service *stubService, n int) [][]string {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if sent := service.sent(); len(sent) >= n {
+			return sent
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("sent = %v, want %d pushes", service.sent(), n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestSchedulerAddCancel 测试添加、查询以及在推送前取消定时推送任务
+func TestSchedulerAddCancel(t *testing.T) {
+	service := &stubService{}
+	s := newTestScheduler(t, store.NewMemoryObjectStore(), service)
+	defer s.Close()
+
+	later, err := s.Add(context.Background(), &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}}, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	soon, err := s.Add(context.Background(), &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"b"}}, time.Now().Add(200*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if _, err := s.Add(context.Background(), &v1.PushRequest{Platform: "android", AppID: "app", Token: []string{"c"}}, time.Now()); err == nil {
+		t.Errorf("Add() unsupported platform error = nil")
+	}
+
+	var ids []string
+	for _, job := range s.List("ios", "app") {
+		ids = append(ids, job.ID)
+	}
+	if want := []string{soon.ID, later.ID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+	if jobs := s.List("ios", "other"); len(jobs) != 0 {
+		t.Errorf("List() other app = %v", jobs)
+	}
+
+	// Test a cancelled job is not pushed
+	if err := s.Cancel(soon.ID); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if err := s.Cancel(soon.ID); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Cancel() twice error = %v, want %v", err, ErrJobNotFound)
+	}
+	time.Sleep(400 * time.Millisecond)
+	if sent := service.sent(); len(sent) != 0 {
+		t.Errorf("cancelled job is pushed: %v", sent)
+	}
+	if jobs := s.List("", ""); len(jobs) != 1 || jobs[0].ID != later.ID {
+		t.Errorf("List() after Cancel() = %v", jobs)
+	}
+
+	var disabled *Scheduler
+	if _, err := disabled.Add(context.Background(), &v1.PushRequest{}, time.Now()); !errors.Is(err, ErrSchedulerDisabled) {
+		t.Errorf("Add() disabled error = %v, want %v", err, ErrSchedulerDisabled)
+	}
+}
+
+// TestSchedulerRestart 测试重启期间已到推送时间的任务在启动后立即推送，推送后从 store 中删除
+func TestSchedulerRestart(t *testing.T) {
+	s := store.NewMemoryObjectStore()
+	first := newTestScheduler(t, s, &stubService{})
+	job, err := first.Add(context.Background(), &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a", "b"}}, time.Now().Add(200*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := first.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	service := &stubService{}
+	second := newTestScheduler(t, s, service)
+	defer second.Close()
+
+	if sent := waitSent(t, service, 1); !reflect.DeepEqual(sent, [][]string{{"a", "b"}}) {
+		t.Errorf("sent = %v", sent)
+	}
+	if _, ok := s.Get(job.ID); ok {
+		t.Errorf("pushed job is kept in store")
+	}
+}
+
+// TestSchedulerSubmitRetry 测试均匀推送的任务提交到异步推送任务失败时保留任务并在 submitRetryInterval 后重新提交
+func TestSchedulerSubmitRetry(t *testing.T) {
+	defer func(jobs *pushjob.Manager) {
+		pushjob.PushJobs = jobs
+	}(pushjob.PushJobs)
+
+	service := &stubService{}
+	d := newTestDispatcher(t, service)
+	// 队列只能容纳一个任务，未启动 worker，提交一个任务后队列已满
+	full := pushjob.NewManager(store.NewMemoryObjectStore(), store.NewMemoryQueue(), d, lane.New(map[string]config.LaneConfig{lane.PriorityNormal: {QueueSize: 1}}), config.AsyncConfig{}, logr.Discard())
+	if _, err := full.Submit(&v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"x"}}); err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		jobs *pushjob.Manager
+	}{
+		{"disabled", nil},
+		{"queue full", full},
+	}
+	for _, tt := range tests {
+		pushjob.PushJobs = tt.jobs
+		s := store.NewMemoryObjectStore()
+		scheduler := newTestScheduler(t, s, service)
+
+		start := time.Now()
+		job, err := scheduler.Add(context.Background(), &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}, Option: &v1.PushOption{Spread: 60}}, start)
+		if err != nil {
+			t.Fatalf("%s: Add() error = %v", tt.name, err)
+		}
+
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			value, ok := s.Get(job.ID)
+			if !ok {
+				t.Fatalf("%s: job is deleted after failed submit", tt.name)
+			}
+			saved := &Job{}
+			if err := json.Unmarshal(value, saved); err != nil {
+				t.Fatalf("%s: Unmarshal() error = %v", tt.name, err)
+			}
+			scheduler.mutex.Lock()
+			_, scheduled := scheduler.jobs[job.ID]
+			scheduler.mutex.Unlock()
+			if scheduled && !saved.ScheduledAt.Before(start.Add(submitRetryInterval)) {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("%s: job is not rescheduled, scheduled_at = %v, scheduled = %v", tt.name, saved.ScheduledAt, scheduled)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if jobs := scheduler.List("", ""); len(jobs) != 1 {
+			t.Errorf("%s: List() = %v", tt.name, jobs)
+		}
+		scheduler.Close()
+	}
+	if sent := service.sent(); len(sent) != 0 {
+		t.Errorf("spread push is sent directly: %v", sent)
+	}
+
+	// Test the job is deleted once the spread push is submitted
+	jobs := pushjob.NewManager(store.NewMemoryObjectStore(), store.NewMemoryQueue(), d, lane.New(nil), config.AsyncConfig{}, logr.Discard())
+	pushjob.PushJobs = jobs
+	s := store.NewMemoryObjectStore()
+	scheduler := newTestScheduler(t, s, service)
+	defer scheduler.Close()
+	job, err := scheduler.Add(context.Background(), &v1.PushRequest{Platform: "ios", AppID: "app", Token: []string{"a"}, Option: &v1.PushOption{Spread: 60}}, time.Now())
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := s.Get(job.ID); !ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("submitted job is kept in store")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
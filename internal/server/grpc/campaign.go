@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/campaign"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (h *Handler) CreateCampaign(ctx context.Context, req *v1.CreateCampaignRequest) (*v1.Campaign, error) {
+	h.logger.Info("Received create campaign request", "name", req.Name, "platform", req.GetRequest().GetPlatform(), "start_at", req.StartAt, "batch_size", req.BatchSize, "rate", req.Rate)
+
+	tokens, err := campaign.ParseTokens(req.TokenFile)
+	if err != nil {
+		return nil, campaignError(err)
+	}
+	var startAt time.Time
+	if req.StartAt > 0 {
+		startAt = time.Unix(req.StartAt, 0)
+	}
+	c, err := campaign.PushCampaigns.Create(req.Name, req.Request, tokens, startAt, int(req.BatchSize), int(req.Rate))
+	if err != nil {
+		h.logger.Error(err, "failed to create campaign")
+		return nil, campaignError(err)
+	}
+	return c.Campaign(), nil
+}
+
+func (h *Handler) GetCampaign(ctx context.Context, req *v1.CampaignRequest) (*v1.Campaign, error) {
+	c, err := campaign.PushCampaigns.Get(req.ID)
+	if err != nil {
+		return nil, campaignError(err)
+	}
+	return c.Campaign(), nil
+}
+
+func (h *Handler) ListCampaigns(ctx context.Context, req *v1.ListCampaignsRequest) (*v1.ListCampaignsResponse, error) {
+	resp := &v1.ListCampaignsResponse{}
+	for _, c := range campaign.PushCampaigns.List(req.Platform, req.AppID, req.Status) {
+		resp.Campaigns = append(resp.Campaigns, c.Campaign())
+	}
+	return resp, nil
+}
+
+func (h *Handler) StartCampaign(ctx context.Context, req *v1.CampaignRequest) (*v1.Campaign, error) {
+	return h.controlCampaign(req.ID, "start", campaign.PushCampaigns.Launch)
+}
+
+func (h *Handler) PauseCampaign(ctx context.Context, req *v1.CampaignRequest) (*v1.Campaign, error) {
+	return h.controlCampaign(req.ID, "pause", campaign.PushCampaigns.Pause)
+}
+
+func (h *Handler) ResumeCampaign(ctx context.Context, req *v1.CampaignRequest) (*v1.Campaign, error) {
+	return h.controlCampaign(req.ID, "resume", campaign.PushCampaigns.Resume)
+}
+
+func (h *Handler) CancelCampaign(ctx context.Context, req *v1.CampaignRequest) (*v1.Campaign, error) {
+	return h.controlCampaign(req.ID, "cancel", campaign.PushCampaigns.Cancel)
+}
+
+// controlCampaign 启动、暂停、恢复或取消推送活动，返回修改后的活动
+func (h *Handler) controlCampaign(id, action string, fn func(id string) (*campaign.Campaign, error)) (*v1.Campaign, error) {
+	h.logger.Info("Received "+action+" campaign request", "id", id)
+
+	c, err := fn(id)
+	if err != nil {
+		return nil, campaignError(err)
+	}
+	return c.Campaign(), nil
+}
+
+// campaignError 活动不存在时返回 codes.NotFound，活动状态不支持该操作时返回 codes.FailedPrecondition，
+// 其他错误返回 codes.InvalidArgument
+func campaignError(err error) error {
+	if errors.Is(err, campaign.ErrCampaignNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, campaign.ErrInvalidState) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, campaign.ErrCampaignsDisabled) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
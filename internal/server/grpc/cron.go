@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/scheduler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+func (h *Handler) CreateCronJob(ctx context.Context, req *v1.CreateCronJobRequest) (*v1.CronJob, error) {
+	h.logger.Info("Received create cron job request", "name", req.Name, "cron", req.Cron, "timezone", req.Timezone)
+
+	job, err := scheduler.PushCronScheduler.Add(ctx, req.Name, req.Cron, req.Timezone, req.Request)
+	if err != nil {
+		h.logger.Error(err, "failed to create cron job")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return job.CronJob(), nil
+}
+
+func (h *Handler) GetCronJob(ctx context.Context, req *v1.GetCronJobRequest) (*v1.CronJob, error) {
+	job, err := scheduler.PushCronScheduler.Get(req.ID)
+	if err != nil {
+		return nil, cronError(err)
+	}
+	return job.CronJob(), nil
+}
+
+func (h *Handler) ListCronJobs(ctx context.Context, req *v1.ListCronJobsRequest) (*v1.ListCronJobsResponse, error) {
+	resp := &v1.ListCronJobsResponse{}
+	for _, job := range scheduler.PushCronScheduler.List(req.Platform, req.AppID) {
+		resp.Jobs = append(resp.Jobs, job.CronJob())
+	}
+	return resp, nil
+}
+
+func (h *Handler) DeleteCronJob(ctx context.Context, req *v1.DeleteCronJobRequest) (*v1.PushResponse, error) {
+	h.logger.Info("Received delete cron job request", "id", req.ID)
+
+	if err := scheduler.PushCronScheduler.Delete(req.ID); err != nil {
+		return nil, cronError(err)
+	}
+	return &v1.PushResponse{Code: http.StatusOK, Msg: "Cron job deleted"}, nil
+}
+
+func cronError(err error) error {
+	if errors.Is(err, scheduler.ErrCronJobNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
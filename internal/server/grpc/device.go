@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"github.com/cossim/hipush/api/pb/v1"
+	push2 "github.com/cossim/hipush/api/push"
+)
+
+func (h *Handler) CheckDevice(ctx context.Context, req *v1.CheckDeviceRequest) (*v1.CheckDeviceResponse, error) {
+	h.logger.Info("Received check device request", "platform", req.Platform, "appid", req.AppID, "tokens", req.Token)
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := service.CheckDevice(ctx, req, &push2.CheckDeviceOptions{Timeout: int(req.Timeout)})
+	if err != nil {
+		h.logger.Error(err, "failed to check device")
+		return nil, err
+	}
+
+	resp := &v1.CheckDeviceResponse{}
+	for _, r := range result.Results {
+		resp.Results = append(resp.Results, &v1.DeviceResult{
+			Token:       r.Token,
+			Status:      r.Status,
+			Reason:      r.Reason,
+			Environment: r.Environment,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"errors"
+	push2 "github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/internal/template"
+	"github.com/cossim/hipush/pkg/device"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pushError 超出每日推送配额时返回 codes.ResourceExhausted，厂商接口熔断时返回 codes.Unavailable，
+// 按用户或分群推送时没有匹配的设备、消息模板不存在返回 codes.NotFound，
+// 分群表达式、消息模板、投递时间窗口、内容变体错误返回 codes.InvalidArgument
+func pushError(err error) error {
+	if errors.Is(err, dispatcher.ErrNoDevice) || errors.Is(err, template.ErrTemplateNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, device.ErrInvalidSegment) || errors.Is(err, template.ErrInvalidTemplate) || errors.Is(err, dispatcher.ErrInvalidDeliveryWindow) ||
+		errors.Is(err, dispatcher.ErrInvalidVariants) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, push2.ErrQuotaExceeded) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.Is(err, push2.ErrCircuitOpen) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return err
+}
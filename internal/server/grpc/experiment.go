@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/pkg/experiment"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+func (h *Handler) GetExperiment(ctx context.Context, req *v1.GetExperimentRequest) (*v1.Experiment, error) {
+	e, err := experiment.PushExperiments.Get(req.ID)
+	if err != nil {
+		return nil, experimentError(err)
+	}
+	return experimentPB(e), nil
+}
+
+func (h *Handler) ListExperiments(ctx context.Context, req *v1.ListExperimentsRequest) (*v1.ListExperimentsResponse, error) {
+	resp := &v1.ListExperimentsResponse{}
+	for _, e := range experiment.PushExperiments.List() {
+		resp.Experiments = append(resp.Experiments, experimentPB(e))
+	}
+	return resp, nil
+}
+
+// ReportClick 客户端上报推送的点击，累加设备分配到的变体的点击数量
+func (h *Handler) ReportClick(ctx context.Context, req *v1.ReportClickRequest) (*v1.PushResponse, error) {
+	if req.Experiment == "" {
+		return nil, status.Error(codes.InvalidArgument, "experiment is required")
+	}
+	variant, err := experiment.PushExperiments.Click(req.Experiment, req.Variant, req.Token)
+	if err != nil {
+		return nil, experimentError(err)
+	}
+	return &v1.PushResponse{Code: http.StatusOK, Msg: "Click reported to variant " + variant}, nil
+}
+
+// experimentPB 转换为 pb 结构
+func experimentPB(e *experiment.Experiment) *v1.Experiment {
+	pb := &v1.Experiment{
+		ID:        e.ID,
+		CreatedAt: e.CreatedAt.Unix(),
+		UpdatedAt: e.UpdatedAt.Unix(),
+	}
+	for _, v := range e.Variants {
+		pb.Variants = append(pb.Variants, &v1.VariantStat{
+			Name:    v.Name,
+			Weight:  int32(v.Weight),
+			Send:    v.Send,
+			Success: v.Success,
+			Failure: v.Failure,
+			Click:   v.Click,
+			TaskIDs: v.TaskIDs,
+		})
+	}
+	return pb
+}
+
+func experimentError(err error) error {
+	if errors.Is(err, experiment.ErrExperimentNotFound) || errors.Is(err, experiment.ErrVariantNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
@@ -8,12 +8,15 @@ import (
 	"github.com/cossim/hipush/api/pb/v1"
 	push2 "github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
 	"github.com/cossim/hipush/internal/factory"
-	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/internal/lane"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/logr"
 	"google.golang.org/grpc"
 	"net"
+	"net/http"
+	"time"
 )
 
 type Handler struct {
@@ -65,8 +68,39 @@ func (h *Handler) Start(ctx context.Context) error {
 }
 
 func (h *Handler) Push(ctx context.Context, req *v1.PushRequest) (*v1.PushResponse, error) {
-	resp := &v1.PushResponse{}
 	h.logger.Info("Received push request", "platform", req.Platform, "tokens", req.Token, "req", req)
+	if len(req.Token) == 0 && !dispatcher.UsesRegistry(req) {
+		return nil, dispatcher.ErrTokenRequired
+	}
+
+	if req.IdempotencyKey != "" {
+		return h.idempotentPush(ctx, req)
+	}
+	return h.push(ctx, req)
+}
+
+func (h *Handler) push(ctx context.Context, req *v1.PushRequest) (*v1.PushResponse, error) {
+	resp := &v1.PushResponse{}
+
+	if scheduledAt := req.GetOption().GetScheduledAt(); scheduledAt > time.Now().Unix() {
+		return h.schedulePush(ctx, req, time.Unix(scheduledAt, 0))
+	}
+	if req.GetOption().GetAsync() || req.GetOption().GetSpread() > 0 {
+		return h.submitPushJob(req)
+	}
+
+	if dispatcher.UsesRegistry(req) {
+		return h.pushToUsers(ctx, req)
+	}
+	if len(req.Variants) > 0 {
+		return h.pushVariants(ctx, req)
+	}
+
+	release, err := lane.PushLanes.Acquire(ctx, req.Priority)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	service, err := h.factory.GetPushService(req.Platform)
 	if err != nil {
@@ -88,33 +122,14 @@ func (h *Handler) Push(ctx context.Context, req *v1.PushRequest) (*v1.PushRespon
 		Token:   req.Token,
 	}
 
-	var r push2.SendRequest
-	switch consts.Platform(req.Platform) {
-	case consts.PlatformIOS:
-		r = &v1.APNsPushRequest{Meta: meta}
-	case consts.PlatformAndroid:
-		r = &v1.AndroidPushRequestData{Meta: meta}
-	case consts.PlatformHuawei:
-		r = &v1.HuaweiPushRequestData{Meta: meta}
-	case consts.PlatformXiaomi:
-		r = &v1.XiaomiPushRequestData{Meta: meta}
-	case consts.PlatformVivo:
-		r = &v1.VivoPushRequestData{Meta: meta}
-	case consts.PlatformOppo:
-		r = &v1.OppoPushRequestData{Meta: meta}
-	case consts.PlatformMeizu:
-		r = &v1.MeizuPushRequestData{Meta: meta}
-	case consts.PlatformHonor:
-		r = &v1.HonorPushRequestData{Meta: meta}
-	default:
-		return nil, errors.New("platform not supported")
-	}
-
-	marshalJSON, err := req.Data.MarshalJSON()
+	data, localized, err := dispatcher.TemplateData(req.Platform, req.TemplateID, req.Variables, req.Data, req.Localized)
 	if err != nil {
-		return nil, err
+		return nil, pushError(err)
 	}
-	if err := json.Unmarshal(marshalJSON, &r); err != nil {
+	req.Localized = localized
+
+	r, err := dispatcher.NewSendRequest(req.Platform, meta, data)
+	if err != nil {
 		h.logger.Error(err, "Failed to unmarshal data")
 		return resp, err
 	}
@@ -123,33 +138,33 @@ func (h *Handler) Push(ctx context.Context, req *v1.PushRequest) (*v1.PushRespon
 		return nil, err
 	}
 
-	option := v1.PushOption{}
-	if req.Option != nil {
-		option.Development = req.Option.Development
-		option.DryRun = req.Option.DryRun
-		option.Retry = req.Option.Retry
-		option.RetryInterval = req.Option.RetryInterval
-	}
-
 	fmt.Println("r => ", r)
 
 	status.StatStorage.AddGrpcTotal(1)
-	_, err = service.Send(ctx, r, &push2.SendOptions{
-		DryRun:        option.DryRun,
-		Retry:         option.Retry,
-		RetryInterval: option.RetryInterval,
-	})
+	sendResp, err := dispatcher.Send(ctx, service, req, r)
+	resp.Results = dispatcher.TokenResults(sendResp)
+	resp.Deferred = deferredCount(sendResp)
 	if err != nil {
 		status.StatStorage.AddGrpcFailed(1)
 		h.logger.Error(err, "failed to send push")
-		return resp, err
+		return resp, pushError(err)
 	}
 	status.StatStorage.AddGrpcSuccess(1)
 
+	resp.Code = http.StatusOK
+	resp.Msg = dispatcher.ResultMsg(sendResp)
+
 	h.logger.Info("Push request processed success")
 	return resp, nil
 }
 
+func deferredCount(resp *push2.SendResponse) int32 {
+	if resp == nil {
+		return 0
+	}
+	return int32(resp.Deferred)
+}
+
 func (h *Handler) validatePushRequest(req push2.SendRequest) error {
 	if req == nil {
 		return errors.New("request is nil")
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/pkg/idempotency"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idempotentResult 保存的推送结果
+type idempotentResult struct {
+	Response *v1.PushResponse `json:"response,omitempty"`
+	Code     codes.Code       `json:"code"`
+	Msg      string           `json:"msg,omitempty"`
+}
+
+// idempotentPush 有效期内相同幂等 key 的推送请求直接返回第一次推送的结果，
+// 只保存推送成功（包括部分成功）的结果，推送失败后使用相同 key 重试会重新推送
+func (h *Handler) idempotentPush(ctx context.Context, req *v1.PushRequest) (*v1.PushResponse, error) {
+	value, replayed, err := idempotency.PushKeys.Do(ctx, "grpc/"+req.IdempotencyKey, func() ([]byte, bool) {
+		resp, err := h.push(ctx, req)
+		result := &idempotentResult{Response: resp}
+		if err != nil {
+			s := status.Convert(err)
+			result.Code, result.Msg = s.Code(), s.Message()
+		}
+		value, merr := json.Marshal(result)
+		if merr != nil {
+			return nil, false
+		}
+		return value, err == nil
+	})
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	if replayed {
+		h.logger.Info("Replay push response for idempotency key", "key", req.IdempotencyKey)
+	}
+
+	result := &idempotentResult{}
+	if err := json.Unmarshal(value, result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if result.Code != codes.OK {
+		return result.Response, status.Error(result.Code, result.Msg)
+	}
+	return result.Response, nil
+}
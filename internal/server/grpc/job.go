@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/job"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+// submitPushJob 将推送请求放入异步推送队列，立即返回任务id
+func (h *Handler) submitPushJob(req *v1.PushRequest) (*v1.PushResponse, error) {
+	j, err := job.PushJobs.Submit(req)
+	if err != nil {
+		h.logger.Error(err, "failed to submit push job")
+		if errors.Is(err, job.ErrQueueFull) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data, err := v1.ToStructPB(map[string]interface{}{"id": j.ID, "status": j.Status})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PushResponse{Code: http.StatusAccepted, Msg: "Push job accepted", Data: data}, nil
+}
+
+func (h *Handler) GetJob(ctx context.Context, req *v1.GetJobRequest) (*v1.PushJob, error) {
+	j, err := job.PushJobs.Get(req.ID)
+	if err != nil {
+		if errors.Is(err, job.ErrJobNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return j.PushJob(), nil
+}
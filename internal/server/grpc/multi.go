@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/status"
+	"net/http"
+)
+
+// MultiPush 将同一推送内容推送到多个平台的设备，每个目标并发推送，所有目标都推送失败时返回错误
+func (h *Handler) MultiPush(ctx context.Context, req *v1.MultiPushRequest) (*v1.MultiPushResponse, error) {
+	h.logger.Info("Received multi-target push request", "targets", len(req.Targets))
+
+	d := dispatcher.New(h.factory)
+	if err := d.CheckFanout(req); err != nil {
+		return nil, err
+	}
+
+	status.StatStorage.AddGrpcTotal(1)
+	resp := &v1.MultiPushResponse{}
+	results, err := d.Fanout(ctx, req)
+	resp.Targets = results
+	if err != nil {
+		status.StatStorage.AddGrpcFailed(1)
+		h.logger.Error(err, "failed to send multi-target push")
+		return resp, pushError(err)
+	}
+	status.StatStorage.AddGrpcSuccess(1)
+
+	var success int
+	for _, r := range results {
+		if r.Success {
+			success++
+		}
+	}
+	resp.Code = http.StatusOK
+	resp.Msg = "Push notification send success"
+	if success < len(results) {
+		resp.Msg = fmt.Sprintf("Push notification partially sent, success targets: %d, failure targets: %d", success, len(results)-success)
+	}
+	return resp, nil
+}
+
+// pushToUsers 推送到设备注册表中用户注册的设备或匹配分群的设备，合并返回所有设备的推送结果
+func (h *Handler) pushToUsers(ctx context.Context, req *v1.PushRequest) (*v1.PushResponse, error) {
+	status.StatStorage.AddGrpcTotal(1)
+	resp := &v1.PushResponse{}
+	sendResp, err := dispatcher.New(h.factory).Push(ctx, req)
+	resp.Results = dispatcher.TokenResults(sendResp)
+	resp.Deferred = deferredCount(sendResp)
+	if err != nil {
+		status.StatStorage.AddGrpcFailed(1)
+		h.logger.Error(err, "failed to push to users", "user_ids", req.UserIDs, "segment", req.Segment)
+		return resp, pushError(err)
+	}
+	status.StatStorage.AddGrpcSuccess(1)
+
+	resp.Code = http.StatusOK
+	resp.Msg = dispatcher.ResultMsg(sendResp)
+	return resp, nil
+}
+
+// pushVariants 按变体拆分设备后推送每个变体的内容，合并返回所有设备的推送结果
+func (h *Handler) pushVariants(ctx context.Context, req *v1.PushRequest) (*v1.PushResponse, error) {
+	status.StatStorage.AddGrpcTotal(1)
+	resp := &v1.PushResponse{}
+	sendResp, err := dispatcher.New(h.factory).Push(ctx, req)
+	resp.Results = dispatcher.TokenResults(sendResp)
+	resp.Deferred = deferredCount(sendResp)
+	if err != nil {
+		status.StatStorage.AddGrpcFailed(1)
+		h.logger.Error(err, "failed to push variants", "experiment", req.Experiment)
+		return resp, pushError(err)
+	}
+	status.StatStorage.AddGrpcSuccess(1)
+
+	resp.Code = http.StatusOK
+	resp.Msg = dispatcher.ResultMsg(sendResp)
+	return resp, nil
+}
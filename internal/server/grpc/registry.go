@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/device"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+func (h *Handler) RegisterDevice(ctx context.Context, req *v1.Device) (*v1.Device, error) {
+	h.logger.Info("Received register device request", "user_id", req.UserID, "platform", req.Platform, "appid", req.AppID)
+
+	d, err := device.PushDevices.Register(device.Device{
+		UserID:     req.UserID,
+		Platform:   req.Platform,
+		AppID:      req.AppID,
+		Token:      req.Token,
+		Locale:     req.Locale,
+		Timezone:   req.Timezone,
+		AppVersion: req.AppVersion,
+		Tags:       req.Tags,
+		Attributes: req.Attributes,
+	})
+	if err != nil {
+		return nil, deviceError(err)
+	}
+	return devicePB(d), nil
+}
+
+func (h *Handler) UnregisterDevice(ctx context.Context, req *v1.UnregisterDeviceRequest) (*v1.PushResponse, error) {
+	h.logger.Info("Received unregister device request", "platform", req.Platform, "appid", req.AppID)
+
+	if err := device.PushDevices.Unregister(req.Platform, req.AppID, req.Token); err != nil {
+		return nil, deviceError(err)
+	}
+	return &v1.PushResponse{Code: http.StatusOK, Msg: "Unregister device success"}, nil
+}
+
+func (h *Handler) RefreshDevice(ctx context.Context, req *v1.RefreshDeviceRequest) (*v1.Device, error) {
+	h.logger.Info("Received refresh device request", "platform", req.Platform, "appid", req.AppID)
+
+	d, err := device.PushDevices.Refresh(req.Platform, req.AppID, req.Token, req.NewToken)
+	if err != nil {
+		return nil, deviceError(err)
+	}
+	return devicePB(d), nil
+}
+
+func (h *Handler) ListDevices(ctx context.Context, req *v1.ListDevicesRequest) (*v1.ListDevicesResponse, error) {
+	h.logger.Info("Received list devices request", "user_id", req.UserID, "platform", req.Platform, "appid", req.AppID)
+
+	resp := &v1.ListDevicesResponse{}
+	for _, d := range device.PushDevices.List(req.UserID, req.Platform, req.AppID) {
+		resp.Devices = append(resp.Devices, devicePB(d))
+	}
+	return resp, nil
+}
+
+func (h *Handler) TagDevice(ctx context.Context, req *v1.TagDeviceRequest) (*v1.Device, error) {
+	h.logger.Info("Received tag device request", "platform", req.Platform, "appid", req.AppID, "tags", req.Tags)
+
+	d, err := device.PushDevices.Tag(req.Platform, req.AppID, req.Token, req.Tags, req.Attributes)
+	if err != nil {
+		return nil, deviceError(err)
+	}
+	return devicePB(d), nil
+}
+
+func (h *Handler) PreviewSegment(ctx context.Context, req *v1.PreviewSegmentRequest) (*v1.PreviewSegmentResponse, error) {
+	h.logger.Info("Received preview segment request", "segment", req.Segment, "user_ids", req.UserIDs, "platform", req.Platform, "appid", req.AppID)
+
+	q, err := dispatcher.DeviceQuery(req.UserIDs, req.Segment, req.Platform, req.AppID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := &v1.PreviewSegmentResponse{}
+	for _, t := range device.PushDevices.Resolve(q) {
+		resp.Count += int64(len(t.Tokens))
+		resp.Targets = append(resp.Targets, &v1.SegmentTarget{Platform: t.Platform, AppID: t.AppID, Count: int64(len(t.Tokens))})
+	}
+	return resp, nil
+}
+
+// devicePB 转换为 pb 结构
+func devicePB(d device.Device) *v1.Device {
+	return &v1.Device{
+		UserID:     d.UserID,
+		Platform:   d.Platform,
+		AppID:      d.AppID,
+		Token:      d.Token,
+		Locale:     d.Locale,
+		Timezone:   d.Timezone,
+		AppVersion: d.AppVersion,
+		Tags:       d.Tags,
+		Attributes: d.Attributes,
+		CreatedAt:  d.CreatedAt.Unix(),
+		UpdatedAt:  d.UpdatedAt.Unix(),
+	}
+}
+
+// deviceError 设备不存在时返回 codes.NotFound，其他错误返回 codes.InvalidArgument
+func deviceError(err error) error {
+	if errors.Is(err, device.ErrDeviceNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/scheduler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+// schedulePush 保存定时推送任务，到达推送时间后由 scheduler 推送
+func (h *Handler) schedulePush(ctx context.Context, req *v1.PushRequest, at time.Time) (*v1.PushResponse, error) {
+	job, err := scheduler.PushScheduler.Add(ctx, req, at)
+	if err != nil {
+		h.logger.Error(err, "failed to schedule push")
+		return nil, err
+	}
+
+	data, err := v1.ToStructPB(map[string]interface{}{"id": job.ID, "scheduled_at": job.ScheduledAt.Unix()})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PushResponse{Code: http.StatusOK, Msg: "Push notification scheduled", Data: data}, nil
+}
+
+func (h *Handler) ListScheduledPushes(ctx context.Context, req *v1.ListScheduledPushesRequest) (*v1.ListScheduledPushesResponse, error) {
+	resp := &v1.ListScheduledPushesResponse{}
+	for _, job := range scheduler.PushScheduler.List(req.Platform, req.AppID) {
+		resp.Pushes = append(resp.Pushes, job.ScheduledPush())
+	}
+	return resp, nil
+}
+
+func (h *Handler) CancelScheduledPush(ctx context.Context, req *v1.CancelScheduledPushRequest) (*v1.PushResponse, error) {
+	h.logger.Info("Received cancel scheduled push request", "id", req.ID)
+
+	if err := scheduler.PushScheduler.Cancel(req.ID); err != nil {
+		if errors.Is(err, scheduler.ErrJobNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &v1.PushResponse{Code: http.StatusOK, Msg: "Scheduled push cancelled"}, nil
+}
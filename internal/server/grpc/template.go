@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/template"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+func (h *Handler) SaveTemplate(ctx context.Context, req *v1.MessageTemplate) (*v1.MessageTemplate, error) {
+	h.logger.Info("Received save template request", "id", req.ID)
+
+	t, err := template.PushTemplates.Save(template.FromProto(req))
+	if err != nil {
+		h.logger.Error(err, "failed to save template")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return t.Proto(), nil
+}
+
+func (h *Handler) GetTemplate(ctx context.Context, req *v1.GetTemplateRequest) (*v1.MessageTemplate, error) {
+	t, err := template.PushTemplates.Get(req.ID)
+	if err != nil {
+		return nil, templateError(err)
+	}
+	return t.Proto(), nil
+}
+
+func (h *Handler) ListTemplates(ctx context.Context, req *v1.ListTemplatesRequest) (*v1.ListTemplatesResponse, error) {
+	resp := &v1.ListTemplatesResponse{}
+	for _, t := range template.PushTemplates.List() {
+		resp.Templates = append(resp.Templates, t.Proto())
+	}
+	return resp, nil
+}
+
+func (h *Handler) DeleteTemplate(ctx context.Context, req *v1.DeleteTemplateRequest) (*v1.PushResponse, error) {
+	h.logger.Info("Received delete template request", "id", req.ID)
+
+	if err := template.PushTemplates.Delete(req.ID); err != nil {
+		return nil, templateError(err)
+	}
+	return &v1.PushResponse{Code: http.StatusOK, Msg: "Template deleted"}, nil
+}
+
+func templateError(err error) error {
+	if errors.Is(err, template.ErrTemplateNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
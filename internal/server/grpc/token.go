@@ -0,0 +1,25 @@
+package grpc
+
+import (
+	"context"
+	"github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/pkg/feedback"
+)
+
+func (h *Handler) ListInvalidTokens(ctx context.Context, req *v1.ListInvalidTokensRequest) (*v1.ListInvalidTokensResponse, error) {
+	h.logger.Info("Received list invalid tokens request", "platform", req.Platform, "appid", req.AppID)
+
+	resp := &v1.ListInvalidTokensResponse{}
+	for _, t := range feedback.InvalidTokenStorage.List(req.Platform, req.AppID) {
+		resp.Tokens = append(resp.Tokens, &v1.InvalidToken{
+			Platform:  t.Platform,
+			AppID:     t.AppID,
+			Token:     t.Token,
+			Reason:    t.Reason,
+			Code:      t.Code,
+			Msg:       t.Msg,
+			CreatedAt: t.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/pb/v1"
+	push2 "github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+func (h *Handler) Subscribe(ctx context.Context, req *v1.TopicRequest) (*v1.PushResponse, error) {
+	h.logger.Info("Received subscribe request", "platform", req.Platform, "appid", req.AppID, "topic", req.Topic)
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.Subscribe(ctx, req)
+	return topicResponse(resp, err, "Subscribe topic success")
+}
+
+func (h *Handler) Unsubscribe(ctx context.Context, req *v1.TopicRequest) (*v1.PushResponse, error) {
+	h.logger.Info("Received unsubscribe request", "platform", req.Platform, "appid", req.AppID, "topic", req.Topic)
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.Unsubscribe(ctx, req)
+	return topicResponse(resp, err, "Unsubscribe topic success")
+}
+
+func (h *Handler) PushToTopic(ctx context.Context, req *v1.TopicPushRequest) (*v1.PushResponse, error) {
+	h.logger.Info("Received topic push request", "platform", req.Platform, "appid", req.AppID, "topic", req.Topic)
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := dispatcher.NewSendRequest(req.Platform, &v1.Meta{AppID: req.AppID, AppName: req.AppName}, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	option := req.GetOption()
+	sendResp, err := service.SendToTopic(ctx, req.Topic, r, &push2.TopicOptions{
+		DryRun:        option.GetDryRun(),
+		Development:   option.GetDevelopment(),
+		Retry:         option.GetRetry(),
+		RetryInterval: option.GetRetryInterval(),
+	})
+	resp := &v1.PushResponse{Results: dispatcher.TokenResults(sendResp)}
+	if err != nil {
+		h.logger.Error(err, "failed to send topic push")
+		return resp, topicError(err)
+	}
+
+	resp.Code = http.StatusOK
+	resp.Msg = "Push notification send success"
+	return resp, nil
+}
+
+func (h *Handler) ListTopics(ctx context.Context, req *v1.ListTopicsRequest) (*v1.ListTopicsResponse, error) {
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	topics, err := service.ListTopics(ctx, req)
+	if err != nil {
+		return nil, topicError(err)
+	}
+	return &v1.ListTopicsResponse{Topics: topics}, nil
+}
+
+func topicResponse(resp *push2.TopicResponse, err error, msg string) (*v1.PushResponse, error) {
+	r := &v1.PushResponse{}
+	if resp != nil {
+		r.Results = dispatcher.TokenResults(&push2.SendResponse{Results: resp.Results})
+	}
+	if err != nil {
+		return r, topicError(err)
+	}
+	r.Code = http.StatusOK
+	r.Msg = msg
+	return r, nil
+}
+
+// topicError 推送服务不支持主题订阅时返回 codes.Unimplemented
+func topicError(err error) error {
+	if errors.Is(err, push2.ErrUnsupported) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return pushError(err)
+}
@@ -0,0 +1,129 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/campaign"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"time"
+)
+
+// createCampaignHandler 创建推送活动，上传设备文件时使用 multipart/form-data，
+// campaign 字段为 JSON 格式的 CreateCampaignRequest，file 字段为设备文件
+func (h *Handler) createCampaignHandler(c *gin.Context) {
+	req, err := bindCampaignRequest(c)
+	if err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received create campaign request", "name", req.Name, "platform", req.GetRequest().GetPlatform(), "start_at", req.StartAt, "batch_size", req.BatchSize, "rate", req.Rate)
+
+	tokens, err := campaign.ParseTokens(req.TokenFile)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+	var startAt time.Time
+	if req.StartAt > 0 {
+		startAt = time.Unix(req.StartAt, 0)
+	}
+	cp, err := campaign.PushCampaigns.Create(req.Name, req.Request, tokens, startAt, int(req.BatchSize), int(req.Rate))
+	if err != nil {
+		h.logger.Error(err, "Failed to create campaign")
+		code := campaignErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Campaign created", Data: cp.Campaign()})
+}
+
+func bindCampaignRequest(c *gin.Context) (*v1.CreateCampaignRequest, error) {
+	req := &v1.CreateCampaignRequest{}
+	if c.ContentType() != gin.MIMEMultipartPOSTForm {
+		return req, c.ShouldBindJSON(req)
+	}
+
+	if err := json.Unmarshal([]byte(c.PostForm("campaign")), req); err != nil {
+		return nil, err
+	}
+	header, err := c.FormFile("file")
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) {
+			return req, nil
+		}
+		return nil, err
+	}
+	f, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	req.TokenFile, err = io.ReadAll(f)
+	return req, err
+}
+
+func (h *Handler) listCampaignsHandler(c *gin.Context) {
+	campaigns := []*v1.Campaign{}
+	for _, cp := range campaign.PushCampaigns.List(c.Query("platform"), c.Query("app_id"), c.Query("status")) {
+		campaigns = append(campaigns, cp.Campaign())
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get campaigns success", Data: campaigns})
+}
+
+func (h *Handler) getCampaignHandler(c *gin.Context) {
+	cp, err := campaign.PushCampaigns.Get(c.Param("id"))
+	if err != nil {
+		code := campaignErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get campaign success", Data: cp.Campaign()})
+}
+
+func (h *Handler) startCampaignHandler(c *gin.Context) {
+	h.controlCampaign(c, "start", campaign.PushCampaigns.Launch)
+}
+
+func (h *Handler) pauseCampaignHandler(c *gin.Context) {
+	h.controlCampaign(c, "pause", campaign.PushCampaigns.Pause)
+}
+
+func (h *Handler) resumeCampaignHandler(c *gin.Context) {
+	h.controlCampaign(c, "resume", campaign.PushCampaigns.Resume)
+}
+
+func (h *Handler) cancelCampaignHandler(c *gin.Context) {
+	h.controlCampaign(c, "cancel", campaign.PushCampaigns.Cancel)
+}
+
+// controlCampaign 启动、暂停、恢复或取消推送活动，返回修改后的活动
+func (h *Handler) controlCampaign(c *gin.Context, action string, fn func(id string) (*campaign.Campaign, error)) {
+	id := c.Param("id")
+	h.logger.Info("Received "+action+" campaign request", "id", id)
+
+	cp, err := fn(id)
+	if err != nil {
+		code := campaignErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Campaign " + cp.Status, Data: cp.Campaign()})
+}
+
+func campaignErrorCode(err error) int {
+	if errors.Is(err, campaign.ErrCampaignNotFound) {
+		return http.StatusNotFound
+	}
+	if errors.Is(err, campaign.ErrInvalidState) {
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/scheduler"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func (h *Handler) createCronJobHandler(c *gin.Context) {
+	req := &v1.CreateCronJobRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received create cron job request", "name", req.Name, "cron", req.Cron, "timezone", req.Timezone)
+
+	job, err := scheduler.PushCronScheduler.Add(c, req.Name, req.Cron, req.Timezone, req.Request)
+	if err != nil {
+		h.logger.Error(err, "Failed to create cron job")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Cron job created", Data: job.CronJob()})
+}
+
+func (h *Handler) listCronJobsHandler(c *gin.Context) {
+	platform := c.Query("platform")
+	appID := c.Query("app_id")
+
+	jobs := []*v1.CronJob{}
+	for _, job := range scheduler.PushCronScheduler.List(platform, appID) {
+		jobs = append(jobs, job.CronJob())
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get cron jobs success", Data: jobs})
+}
+
+func (h *Handler) getCronJobHandler(c *gin.Context) {
+	job, err := scheduler.PushCronScheduler.Get(c.Param("id"))
+	if err != nil {
+		code := cronErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get cron job success", Data: job.CronJob()})
+}
+
+func (h *Handler) deleteCronJobHandler(c *gin.Context) {
+	id := c.Param("id")
+	h.logger.Info("Received delete cron job request", "id", id)
+
+	if err := scheduler.PushCronScheduler.Delete(id); err != nil {
+		code := cronErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Cron job deleted", Data: nil})
+}
+
+func cronErrorCode(err error) int {
+	if errors.Is(err, scheduler.ErrCronJobNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func (h *Handler) checkDeviceHandler(c *gin.Context) {
+	req := &v1.CheckDeviceRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received check device request", "platform", req.Platform, "appid", req.AppID, "tokens", req.Token)
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	resp, err := service.CheckDevice(c, req, &push.CheckDeviceOptions{Timeout: int(req.Timeout)})
+	if err != nil {
+		h.logger.Error(err, "Failed to check device")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Check device success", Data: resp})
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/experiment"
+	"github.com/cossim/hipush/pkg/status"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// pushVariants 按变体拆分设备后推送每个变体的内容，合并返回所有设备的推送结果
+func (h *Handler) pushVariants(c *gin.Context, req *v1.PushRequest) {
+	status.StatStorage.AddHttpTotal(1)
+	h.logger.Info("Received A/B test push request", "platform", req.Platform, "appid", req.AppID, "experiment", req.Experiment, "variants", len(req.Variants), "tokens", req.Token)
+
+	resp, err := dispatcher.New(h.factory).Push(c, req)
+	if err != nil {
+		status.StatStorage.AddHttpFailed(1)
+		h.logger.Error(err, "Failed to send A/B test push", "experiment", req.Experiment)
+		code := pushErrorCode(err, http.StatusBadRequest)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: resp})
+		return
+	}
+	status.StatStorage.AddHttpSuccess(1)
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: dispatcher.ResultMsg(resp), Data: resp})
+}
+
+func (h *Handler) listExperimentsHandler(c *gin.Context) {
+	experiments := experiment.PushExperiments.List()
+	if experiments == nil {
+		experiments = []*experiment.Experiment{}
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get experiments success", Data: experiments})
+}
+
+func (h *Handler) getExperimentHandler(c *gin.Context) {
+	e, err := experiment.PushExperiments.Get(c.Param("id"))
+	if err != nil {
+		code := experimentErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get experiment success", Data: e})
+}
+
+// reportClickHandler 客户端上报推送的点击，累加设备分配到的变体的点击数量
+func (h *Handler) reportClickHandler(c *gin.Context) {
+	req := &v1.ReportClickRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	variant, err := experiment.PushExperiments.Click(req.Experiment, req.Variant, req.Token)
+	if err != nil {
+		code := experimentErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Click reported", Data: gin.H{"experiment": req.Experiment, "variant": variant}})
+}
+
+func experimentErrorCode(err error) int {
+	if errors.Is(err, experiment.ErrExperimentNotFound) || errors.Is(err, experiment.ErrVariantNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"github.com/cossim/hipush/api/http/v1/dto"
+	"github.com/cossim/hipush/pkg/breaker"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// healthHandler 返回服务健康状态，任一应用的熔断器未关闭时状态为 degraded
+func (h *Handler) healthHandler(c *gin.Context) {
+	health := &dto.Health{Status: "ok", Breakers: breakerStats()}
+	for _, b := range health.Breakers {
+		if b.State != breaker.StateClosed {
+			health.Status = "degraded"
+			break
+		}
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get health success", Data: health})
+}
+
+func breakerStats() []dto.BreakerStat {
+	stats := make([]dto.BreakerStat, 0)
+	for _, s := range breaker.PushBreakers.States() {
+		stat := dto.BreakerStat{
+			Platform:            s.Platform,
+			AppID:               s.AppID,
+			State:               s.State,
+			Requests:            s.Requests,
+			Failures:            s.Failures,
+			ConsecutiveFailures: s.ConsecutiveFailures,
+		}
+		if !s.OpenedAt.IsZero() {
+			stat.OpenedAt = s.OpenedAt.Unix()
+		}
+		stats = append(stats, stat)
+	}
+	return stats
+}
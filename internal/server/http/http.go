@@ -5,13 +5,17 @@ import (
 	"errors"
 	"fmt"
 	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/internal/dispatcher"
 	"github.com/cossim/hipush/internal/factory"
+	"github.com/cossim/hipush/internal/lane"
 	"github.com/cossim/hipush/pkg/consts"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/gin-gonic/gin"
 	"github.com/go-logr/logr"
 	"net/http"
+	"time"
 )
 
 type Handler struct {
@@ -40,8 +44,43 @@ func (h *Handler) Start(ctx context.Context) error {
 
 	r := gin.Default()
 	r.POST("/api/v1/push", h.pushHandler)
+	r.POST("/api/v1/push/multi", h.multiPushHandler)
 	r.GET("/api/v1/push/stat", h.pushStatHandler)
+	r.GET("/api/v1/health", h.healthHandler)
 	r.GET("/api/v1/message/stat", h.pushMessageStatHandler)
+	r.GET("/api/v1/push/scheduled", h.listScheduledPushesHandler)
+	r.DELETE("/api/v1/push/scheduled/:id", h.cancelScheduledPushHandler)
+	r.POST("/api/v1/cron", h.createCronJobHandler)
+	r.GET("/api/v1/cron", h.listCronJobsHandler)
+	r.GET("/api/v1/cron/:id", h.getCronJobHandler)
+	r.DELETE("/api/v1/cron/:id", h.deleteCronJobHandler)
+	r.GET("/api/v1/jobs/:id", h.getJobHandler)
+	r.POST("/api/v1/template", h.saveTemplateHandler)
+	r.GET("/api/v1/template", h.listTemplatesHandler)
+	r.GET("/api/v1/template/:id", h.getTemplateHandler)
+	r.DELETE("/api/v1/template/:id", h.deleteTemplateHandler)
+	r.GET("/api/v1/tokens/invalid", h.invalidTokensHandler)
+	r.POST("/api/v1/topic/subscribe", h.subscribeHandler)
+	r.POST("/api/v1/topic/unsubscribe", h.unsubscribeHandler)
+	r.POST("/api/v1/topic/push", h.topicPushHandler)
+	r.GET("/api/v1/topic/list", h.listTopicsHandler)
+	r.POST("/api/v1/device/check", h.checkDeviceHandler)
+	r.POST("/api/v1/device/register", h.registerDeviceHandler)
+	r.POST("/api/v1/device/unregister", h.unregisterDeviceHandler)
+	r.POST("/api/v1/device/refresh", h.refreshDeviceHandler)
+	r.GET("/api/v1/device/list", h.listDevicesHandler)
+	r.POST("/api/v1/device/tags", h.tagDeviceHandler)
+	r.POST("/api/v1/device/preview", h.previewSegmentHandler)
+	r.GET("/api/v1/experiment", h.listExperimentsHandler)
+	r.GET("/api/v1/experiment/:id", h.getExperimentHandler)
+	r.POST("/api/v1/experiment/click", h.reportClickHandler)
+	r.POST("/api/v1/campaign", h.createCampaignHandler)
+	r.GET("/api/v1/campaign", h.listCampaignsHandler)
+	r.GET("/api/v1/campaign/:id", h.getCampaignHandler)
+	r.POST("/api/v1/campaign/:id/start", h.startCampaignHandler)
+	r.POST("/api/v1/campaign/:id/pause", h.pauseCampaignHandler)
+	r.POST("/api/v1/campaign/:id/resume", h.resumeCampaignHandler)
+	r.POST("/api/v1/campaign/:id/cancel", h.cancelCampaignHandler)
 
 	srv := &http.Server{
 		Addr:    h.cfg.HTTP.Addr(),
@@ -81,11 +120,57 @@ func (h *Handler) pushHandler(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
 		return
 	}
+	if len(req.Token) == 0 && !dispatcher.UsesRegistry(req) {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: dispatcher.ErrTokenRequired.Error(), Data: nil})
+		return
+	}
+
+	if key := idempotencyKey(c, req); key != "" {
+		h.idempotentPush(c, key, req)
+		return
+	}
+	h.push(c, req)
+}
+
+func (h *Handler) push(c *gin.Context, req *v1.PushRequest) {
+	if scheduledAt := req.GetOption().GetScheduledAt(); scheduledAt > time.Now().Unix() {
+		h.schedulePush(c, req, time.Unix(scheduledAt, 0))
+		return
+	}
+	if req.GetOption().GetAsync() || req.GetOption().GetSpread() > 0 {
+		h.submitPushJob(c, req)
+		return
+	}
+
+	if dispatcher.UsesRegistry(req) {
+		h.pushToUsers(c, req)
+		return
+	}
+	if len(req.Variants) > 0 {
+		h.pushVariants(c, req)
+		return
+	}
+	if req.TemplateID != "" {
+		data, localized, err := dispatcher.TemplateData(req.Platform, req.TemplateID, req.Variables, req.Data, req.Localized)
+		if err != nil {
+			code := templateErrorCode(err)
+			c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+			return
+		}
+		req.Data = data
+		req.Localized = localized
+	}
+
+	release, err := lane.PushLanes.Acquire(c, req.Priority)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+	defer release()
 
 	status.StatStorage.AddHttpTotal(1)
 	h.logger.Info("Received push request", "platform", req.Platform, "appid", req.AppID, "tokens", req.Token, "data", req.Data)
 
-	var err error
 	switch consts.Platform(req.Platform) {
 	case consts.PlatformIOS:
 		err = h.handleIOSPush(c, req)
@@ -115,3 +200,14 @@ func (h *Handler) pushHandler(c *gin.Context) {
 		status.StatStorage.AddHttpSuccess(1)
 	}
 }
+
+// pushErrorCode 超出每日推送配额时返回 429，厂商接口熔断时返回 503，其他错误返回 code
+func pushErrorCode(err error, code int) int {
+	if errors.Is(err, push.ErrQuotaExceeded) {
+		return http.StatusTooManyRequests
+	}
+	if errors.Is(err, push.ErrCircuitOpen) {
+		return http.StatusServiceUnavailable
+	}
+	return code
+}
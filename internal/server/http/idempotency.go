@@ -0,0 +1,73 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/pkg/idempotency"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// idempotencyKey 请求的幂等 key，优先使用 Idempotency-Key 请求头
+func idempotencyKey(c *gin.Context, req *v1.PushRequest) string {
+	if key := c.GetHeader("Idempotency-Key"); key != "" {
+		return key
+	}
+	return req.IdempotencyKey
+}
+
+// idempotentResult 保存的 HTTP 响应
+type idempotentResult struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// idempotentPush 有效期内相同幂等 key 的推送请求直接返回第一次推送的响应，响应头 Idempotent-Replayed 为 true，
+// 只保存推送成功（包括部分成功）的响应，推送失败后使用相同 key 重试会重新推送
+func (h *Handler) idempotentPush(c *gin.Context, key string, req *v1.PushRequest) {
+	value, replayed, err := idempotency.PushKeys.Do(c, "http/"+key, func() ([]byte, bool) {
+		w := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = w
+		h.push(c, req)
+		c.Writer = w.ResponseWriter
+
+		value, err := json.Marshal(&idempotentResult{Status: w.Status(), Body: w.body.Bytes()})
+		if err != nil {
+			return nil, false
+		}
+		return value, w.Status() < http.StatusMultipleChoices
+	})
+	if err != nil {
+		c.JSON(http.StatusRequestTimeout, Response{Code: http.StatusRequestTimeout, Msg: err.Error(), Data: nil})
+		return
+	}
+	if !replayed {
+		return
+	}
+
+	h.logger.Info("Replay push response for idempotency key", "key", key)
+	result := &idempotentResult{}
+	if err := json.Unmarshal(value, result); err != nil {
+		c.JSON(http.StatusInternalServerError, Response{Code: http.StatusInternalServerError, Msg: err.Error(), Data: nil})
+		return
+	}
+	c.Header("Idempotent-Replayed", "true")
+	c.Data(result.Status, "application/json; charset=utf-8", result.Body)
+}
+
+// responseRecorder 记录写入的响应
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/job"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// submitPushJob 将推送请求放入异步推送队列，立即返回任务id
+func (h *Handler) submitPushJob(c *gin.Context, req *v1.PushRequest) {
+	j, err := job.PushJobs.Submit(req)
+	if err != nil {
+		h.logger.Error(err, "Failed to submit push job")
+		code := http.StatusBadRequest
+		if errors.Is(err, job.ErrQueueFull) {
+			code = http.StatusServiceUnavailable
+		}
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, Response{Code: http.StatusAccepted, Msg: "Push job accepted", Data: j.PushJob()})
+}
+
+func (h *Handler) getJobHandler(c *gin.Context) {
+	j, err := job.PushJobs.Get(c.Param("id"))
+	if err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, job.ErrJobNotFound) {
+			code = http.StatusNotFound
+		}
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get push job success", Data: j.PushJob()})
+}
@@ -3,7 +3,7 @@ package http
 import (
 	"encoding/json"
 	v1 "github.com/cossim/hipush/api/pb/v1"
-	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/dispatcher"
 	"github.com/cossim/hipush/pkg/consts"
 	"github.com/gin-gonic/gin"
 	"net/http"
@@ -37,17 +37,12 @@ func (h *Handler) handleMeizuPush(c *gin.Context, req *v1.PushRequest) error {
 		Token:   req.Token,
 	}
 
-	resp, err := service.Send(c, &r, &push.SendOptions{
-		DryRun:        req.Option.DryRun,
-		Development:   req.Option.Development,
-		Retry:         req.Option.Retry,
-		RetryInterval: req.Option.RetryInterval,
-	})
+	resp, err := dispatcher.Send(c, service, req, &r)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		c.JSON(pushErrorCode(err, http.StatusInternalServerError), Response{Code: pushErrorCode(err, http.StatusBadRequest), Msg: err.Error(), Data: resp})
 		return err
 	}
 
-	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Push notification send success", Data: resp})
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: dispatcher.ResultMsg(resp), Data: resp})
 	return nil
 }
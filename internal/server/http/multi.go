@@ -0,0 +1,73 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/status"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// multiPushHandler 将同一推送内容推送到多个平台的设备，每个目标并发推送
+func (h *Handler) multiPushHandler(c *gin.Context) {
+	req := &v1.MultiPushRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.multiPush(c, req)
+}
+
+// pushToUsers 推送到设备注册表中用户注册的设备或匹配分群的设备，返回每个平台及应用的推送结果
+func (h *Handler) pushToUsers(c *gin.Context, req *v1.PushRequest) {
+	m, err := dispatcher.UsersRequest(req)
+	if err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, dispatcher.ErrNoDevice) {
+			code = http.StatusNotFound
+		}
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+	h.multiPush(c, m)
+}
+
+func (h *Handler) multiPush(c *gin.Context, req *v1.MultiPushRequest) {
+	d := dispatcher.New(h.factory)
+	if err := d.CheckFanout(req); err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	status.StatStorage.AddHttpTotal(1)
+	h.logger.Info("Received multi-target push request", "targets", len(req.Targets), "data", req.Data)
+
+	results, err := d.Fanout(c, req)
+	if err != nil {
+		status.StatStorage.AddHttpFailed(1)
+		h.logger.Error(err, "Failed to send multi-target push")
+		c.JSON(pushErrorCode(err, http.StatusInternalServerError), Response{Code: pushErrorCode(err, http.StatusInternalServerError), Msg: err.Error(), Data: results})
+		return
+	}
+	status.StatStorage.AddHttpSuccess(1)
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: targetResultMsg(results), Data: results})
+}
+
+// targetResultMsg 根据每个目标的推送结果生成响应消息，部分目标推送失败时返回部分成功
+func targetResultMsg(results []*v1.PushTargetResult) string {
+	var success int
+	for _, r := range results {
+		if r.Success {
+			success++
+		}
+	}
+	if success < len(results) {
+		return fmt.Sprintf("Push notification partially sent, success targets: %d, failure targets: %d", success, len(results)-success)
+	}
+	return "Push notification send success"
+}
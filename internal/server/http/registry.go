@@ -0,0 +1,142 @@
+package http
+
+import (
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/cossim/hipush/pkg/device"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func (h *Handler) registerDeviceHandler(c *gin.Context) {
+	req := &v1.Device{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received register device request", "user_id", req.UserID, "platform", req.Platform, "appid", req.AppID)
+
+	d, err := device.PushDevices.Register(device.Device{
+		UserID:     req.UserID,
+		Platform:   req.Platform,
+		AppID:      req.AppID,
+		Token:      req.Token,
+		Locale:     req.Locale,
+		Timezone:   req.Timezone,
+		AppVersion: req.AppVersion,
+		Tags:       req.Tags,
+		Attributes: req.Attributes,
+	})
+	if err != nil {
+		h.logger.Error(err, "Failed to register device")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Register device success", Data: d})
+}
+
+func (h *Handler) unregisterDeviceHandler(c *gin.Context) {
+	req := &v1.UnregisterDeviceRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received unregister device request", "platform", req.Platform, "appid", req.AppID)
+
+	if err := device.PushDevices.Unregister(req.Platform, req.AppID, req.Token); err != nil {
+		c.JSON(deviceErrorCode(err), Response{Code: deviceErrorCode(err), Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Unregister device success", Data: nil})
+}
+
+func (h *Handler) refreshDeviceHandler(c *gin.Context) {
+	req := &v1.RefreshDeviceRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received refresh device request", "platform", req.Platform, "appid", req.AppID)
+
+	d, err := device.PushDevices.Refresh(req.Platform, req.AppID, req.Token, req.NewToken)
+	if err != nil {
+		c.JSON(deviceErrorCode(err), Response{Code: deviceErrorCode(err), Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Refresh device success", Data: d})
+}
+
+func (h *Handler) listDevicesHandler(c *gin.Context) {
+	userID := c.Query("user_id")
+	platform := c.Query("platform")
+	appID := c.Query("app_id")
+
+	h.logger.Info("Received list devices request", "user_id", userID, "platform", platform, "appid", appID)
+
+	devices := device.PushDevices.List(userID, platform, appID)
+	if devices == nil {
+		devices = []device.Device{}
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get devices success", Data: devices})
+}
+
+func (h *Handler) tagDeviceHandler(c *gin.Context) {
+	req := &v1.TagDeviceRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received tag device request", "platform", req.Platform, "appid", req.AppID, "tags", req.Tags)
+
+	d, err := device.PushDevices.Tag(req.Platform, req.AppID, req.Token, req.Tags, req.Attributes)
+	if err != nil {
+		c.JSON(deviceErrorCode(err), Response{Code: deviceErrorCode(err), Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Tag device success", Data: d})
+}
+
+func (h *Handler) previewSegmentHandler(c *gin.Context) {
+	req := &v1.PreviewSegmentRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received preview segment request", "segment", req.Segment, "user_ids", req.UserIDs, "platform", req.Platform, "appid", req.AppID)
+
+	q, err := dispatcher.DeviceQuery(req.UserIDs, req.Segment, req.Platform, req.AppID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+	resp := &v1.PreviewSegmentResponse{Targets: []*v1.SegmentTarget{}}
+	for _, t := range device.PushDevices.Resolve(q) {
+		resp.Count += int64(len(t.Tokens))
+		resp.Targets = append(resp.Targets, &v1.SegmentTarget{Platform: t.Platform, AppID: t.AppID, Count: int64(len(t.Tokens))})
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Preview segment success", Data: resp})
+}
+
+// deviceErrorCode 设备不存在时返回 404，其他错误返回 400
+func deviceErrorCode(err error) int {
+	if errors.Is(err, device.ErrDeviceNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/scheduler"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+// schedulePush 保存定时推送任务，到达推送时间后由 scheduler 推送
+func (h *Handler) schedulePush(c *gin.Context, req *v1.PushRequest, at time.Time) {
+	job, err := scheduler.PushScheduler.Add(c, req, at)
+	if err != nil {
+		h.logger.Error(err, "Failed to schedule push")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Push notification scheduled", Data: job.ScheduledPush()})
+}
+
+func (h *Handler) listScheduledPushesHandler(c *gin.Context) {
+	platform := c.Query("platform")
+	appID := c.Query("app_id")
+
+	pushes := []*v1.ScheduledPush{}
+	for _, job := range scheduler.PushScheduler.List(platform, appID) {
+		pushes = append(pushes, job.ScheduledPush())
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get scheduled pushes success", Data: pushes})
+}
+
+func (h *Handler) cancelScheduledPushHandler(c *gin.Context) {
+	id := c.Param("id")
+	h.logger.Info("Received cancel scheduled push request", "id", id)
+
+	if err := scheduler.PushScheduler.Cancel(id); err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, scheduler.ErrJobNotFound) {
+			code = http.StatusNotFound
+		}
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Scheduled push cancelled", Data: nil})
+}
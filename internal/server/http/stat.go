@@ -4,6 +4,7 @@ import (
 	"github.com/cossim/hipush/api/http/v1/dto"
 	api "github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/gin-gonic/gin"
 	"net/http"
@@ -99,6 +100,20 @@ func (h *Handler) pushStatHandler(c *gin.Context) {
 	ps.Honor.Display = status.StatStorage.GetHonorDisplay()
 	ps.Honor.Click = status.StatStorage.GetHonorClick()
 
+	ps.Quota = make([]dto.QuotaStat, 0)
+	for _, u := range ratelimit.PushLimiter.Usage() {
+		ps.Quota = append(ps.Quota, dto.QuotaStat{
+			Platform:   u.Platform,
+			AppID:      u.AppID,
+			QPS:        u.QPS,
+			Burst:      u.Burst,
+			DailyQuota: u.DailyQuota,
+			Used:       u.Used,
+			Remaining:  u.Remaining,
+		})
+	}
+	ps.Breakers = breakerStats()
+
 	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get push stat success", Data: ps})
 }
 
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/internal/template"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func (h *Handler) saveTemplateHandler(c *gin.Context) {
+	req := &v1.MessageTemplate{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received save template request", "id", req.ID)
+
+	t, err := template.PushTemplates.Save(template.FromProto(req))
+	if err != nil {
+		h.logger.Error(err, "Failed to save template")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Template saved", Data: t.Proto()})
+}
+
+func (h *Handler) listTemplatesHandler(c *gin.Context) {
+	templates := []*v1.MessageTemplate{}
+	for _, t := range template.PushTemplates.List() {
+		templates = append(templates, t.Proto())
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get templates success", Data: templates})
+}
+
+func (h *Handler) getTemplateHandler(c *gin.Context) {
+	t, err := template.PushTemplates.Get(c.Param("id"))
+	if err != nil {
+		code := templateErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get template success", Data: t.Proto()})
+}
+
+func (h *Handler) deleteTemplateHandler(c *gin.Context) {
+	id := c.Param("id")
+	h.logger.Info("Received delete template request", "id", id)
+
+	if err := template.PushTemplates.Delete(id); err != nil {
+		code := templateErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Template deleted", Data: nil})
+}
+
+func templateErrorCode(err error) int {
+	if errors.Is(err, template.ErrTemplateNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func (h *Handler) invalidTokensHandler(c *gin.Context) {
+	platform := c.Query("platform")
+	appID := c.Query("app_id")
+
+	h.logger.Info("Received invalid tokens request", "platform", platform, "appid", appID)
+
+	tokens := feedback.InvalidTokenStorage.List(platform, appID)
+	if tokens == nil {
+		tokens = []feedback.InvalidToken{}
+	}
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get invalid tokens success", Data: tokens})
+}
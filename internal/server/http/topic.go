@@ -0,0 +1,126 @@
+package http
+
+import (
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/dispatcher"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func (h *Handler) subscribeHandler(c *gin.Context) {
+	h.handleTopic(c, true)
+}
+
+func (h *Handler) unsubscribeHandler(c *gin.Context) {
+	h.handleTopic(c, false)
+}
+
+func (h *Handler) handleTopic(c *gin.Context, subscribe bool) {
+	req := &v1.TopicRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received topic request", "platform", req.Platform, "appid", req.AppID, "topic", req.Topic, "subscribe", subscribe)
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	var resp *push.TopicResponse
+	if subscribe {
+		resp, err = service.Subscribe(c, req)
+	} else {
+		resp, err = service.Unsubscribe(c, req)
+	}
+	if err != nil {
+		h.logger.Error(err, "Failed to manage topic")
+		code := topicErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: resp})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Topic request success", Data: resp})
+}
+
+func (h *Handler) topicPushHandler(c *gin.Context) {
+	req := &v1.TopicPushRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	h.logger.Info("Received topic push request", "platform", req.Platform, "appid", req.AppID, "topic", req.Topic)
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	r, err := dispatcher.NewSendRequest(req.Platform, &v1.Meta{AppID: req.AppID, AppName: req.AppName}, req.Data)
+	if err != nil {
+		h.logger.Error(err, "Failed to parse data")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: "invalid data", Data: nil})
+		return
+	}
+
+	option := req.GetOption()
+	resp, err := service.SendToTopic(c, req.Topic, r, &push.TopicOptions{
+		DryRun:        option.GetDryRun(),
+		Development:   option.GetDevelopment(),
+		Retry:         option.GetRetry(),
+		RetryInterval: option.GetRetryInterval(),
+	})
+	if err != nil {
+		h.logger.Error(err, "Failed to send topic push")
+		code := topicErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: resp})
+		return
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Push notification send success", Data: resp})
+}
+
+func (h *Handler) listTopicsHandler(c *gin.Context) {
+	req := &v1.ListTopicsRequest{}
+	if err := c.ShouldBindQuery(req); err != nil {
+		h.logger.Error(err, "failed to bind request")
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	service, err := h.factory.GetPushService(req.Platform)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		return
+	}
+
+	topics, err := service.ListTopics(c, req)
+	if err != nil {
+		h.logger.Error(err, "Failed to list topics")
+		code := topicErrorCode(err)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: nil})
+		return
+	}
+	if topics == nil {
+		topics = []string{}
+	}
+
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Get topics success", Data: topics})
+}
+
+// topicErrorCode 推送服务不支持主题订阅时返回 501
+func topicErrorCode(err error) int {
+	if errors.Is(err, push.ErrUnsupported) {
+		return http.StatusNotImplemented
+	}
+	return pushErrorCode(err, http.StatusBadRequest)
+}
@@ -3,7 +3,7 @@ package http
 import (
 	"encoding/json"
 	v1 "github.com/cossim/hipush/api/pb/v1"
-	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/internal/dispatcher"
 	"github.com/cossim/hipush/pkg/consts"
 	"github.com/gin-gonic/gin"
 	"net/http"
@@ -36,15 +36,12 @@ func (h *Handler) handleVivoPush(c *gin.Context, req *v1.PushRequest) error {
 		AppName: req.AppName,
 		Token:   req.Token,
 	}
-	resp, err := service.Send(c, &r, &push.SendOptions{
-		DryRun:        req.Option.DryRun,
-		Retry:         req.Option.Retry,
-		RetryInterval: req.Option.RetryInterval,
-	})
+	resp, err := dispatcher.Send(c, service, req, &r)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, Response{Code: http.StatusBadRequest, Msg: err.Error(), Data: nil})
+		code := pushErrorCode(err, http.StatusBadRequest)
+		c.JSON(code, Response{Code: code, Msg: err.Error(), Data: resp})
 		return err
 	}
-	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: "Push notification send success", Data: resp})
+	c.JSON(http.StatusOK, Response{Code: http.StatusOK, Msg: dispatcher.ResultMsg(resp), Data: resp})
 	return nil
 }
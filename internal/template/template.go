@@ -0,0 +1,480 @@
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/store"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"time"
+)
+
+// PushTemplates 消息模板，推送请求指定 template_id 时使用模板渲染推送内容
+var PushTemplates *Store
+
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+	ErrInvalidTemplate  = errors.New("invalid template")
+)
+
+func InitPushTemplates(cfg *config.Config) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "templates")
+	if err != nil {
+		return err
+	}
+
+	PushTemplates = NewStore(s)
+	if err := PushTemplates.Init(); err != nil {
+		return err
+	}
+	if cfg.Templates.Dir == "" {
+		return nil
+	}
+	return PushTemplates.LoadDir(cfg.Templates.Dir)
+}
+
+// Message 模板的推送内容，字符串使用 text/template 语法，推送时使用请求的 variables 渲染，例如 {{.name}}
+type Message struct {
+	Title       string                 `json:"title,omitempty" yaml:"title"`
+	Content     string                 `json:"content,omitempty" yaml:"content"`
+	ClickAction *ClickAction           `json:"click_action,omitempty" yaml:"click_action"`
+	Data        map[string]interface{} `json:"data,omitempty" yaml:"data"`
+	// Localized 各语言的标题及内容，key 为语言标签，例如 zh-TW
+	Localized map[string]push.LocalizedContent `json:"localized,omitempty" yaml:"localized"`
+}
+
+// ClickAction 点击通知的动作，与推送请求的 click_action 相同
+type ClickAction struct {
+	Action     int32                  `json:"action,omitempty" yaml:"action"`
+	Activity   string                 `json:"activity,omitempty" yaml:"activity"`
+	Url        string                 `json:"url,omitempty" yaml:"url"`
+	Parameters map[string]interface{} `json:"parameters,omitempty" yaml:"parameters"`
+}
+
+// Template 消息模板，Platforms 为各平台覆盖的推送内容，key 为 consts.Platform，
+// 覆盖内容中不为空的字段替换模板的默认内容，data 按字段合并
+type Template struct {
+	ID        string `json:"id" yaml:"id"`
+	Message   `yaml:",inline"`
+	Platforms map[string]Message `json:"platforms,omitempty" yaml:"platforms"`
+	CreatedAt time.Time          `json:"created_at" yaml:"-"`
+	UpdatedAt time.Time          `json:"updated_at" yaml:"-"`
+}
+
+// Validate 校验模板id、平台及模板语法
+func (t *Template) Validate() error {
+	if t.ID == "" {
+		return fmt.Errorf("%w: id is required", ErrInvalidTemplate)
+	}
+	if err := t.Message.validate(); err != nil {
+		return err
+	}
+	for platform, m := range t.Platforms {
+		if !consts.Platform(platform).IsValid() {
+			return fmt.Errorf("%w: invalid platform %s", ErrInvalidTemplate, platform)
+		}
+		if err := m.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render 使用变量渲染指定平台的推送内容，变量不存在时返回错误
+func (t *Template) Render(platform string, variables map[string]interface{}) (Message, error) {
+	m := t.Message
+	if override, ok := t.Platforms[platform]; ok {
+		m = m.merge(override)
+	}
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	var err error
+	r := &renderer{variables: variables}
+	if m.Title, err = r.render(m.Title); err != nil {
+		return m, err
+	}
+	if m.Content, err = r.render(m.Content); err != nil {
+		return m, err
+	}
+	if m.ClickAction != nil {
+		a := *m.ClickAction
+		if a.Activity, err = r.render(a.Activity); err != nil {
+			return m, err
+		}
+		if a.Url, err = r.render(a.Url); err != nil {
+			return m, err
+		}
+		if a.Parameters, err = r.renderMap(a.Parameters); err != nil {
+			return m, err
+		}
+		m.ClickAction = &a
+	}
+	if m.Data, err = r.renderMap(m.Data); err != nil {
+		return m, err
+	}
+	if len(m.Localized) > 0 {
+		localized := make(map[string]push.LocalizedContent, len(m.Localized))
+		for l, c := range m.Localized {
+			if c.Title, err = r.render(c.Title); err != nil {
+				return m, err
+			}
+			if c.Content, err = r.render(c.Content); err != nil {
+				return m, err
+			}
+			localized[l] = c
+		}
+		m.Localized = localized
+	}
+	return m, nil
+}
+
+// Proto 转换为 pb 结构
+func (t *Template) Proto() *v1.MessageTemplate {
+	pb := &v1.MessageTemplate{
+		ID:        t.ID,
+		CreatedAt: t.CreatedAt.Unix(),
+		UpdatedAt: t.UpdatedAt.Unix(),
+	}
+	pb.Title, pb.Content, pb.ClickAction, pb.Data, pb.Localized = t.Message.proto()
+	if len(t.Platforms) > 0 {
+		pb.Platforms = make(map[string]*v1.TemplateMessage, len(t.Platforms))
+		for platform, m := range t.Platforms {
+			tm := &v1.TemplateMessage{}
+			tm.Title, tm.Content, tm.ClickAction, tm.Data, tm.Localized = m.proto()
+			pb.Platforms[platform] = tm
+		}
+	}
+	return pb
+}
+
+// FromProto 根据 pb 结构创建模板
+func FromProto(pb *v1.MessageTemplate) *Template {
+	t := &Template{
+		ID:      pb.GetID(),
+		Message: newMessage(pb.GetTitle(), pb.GetContent(), pb.GetClickAction(), pb.GetData(), pb.GetLocalized()),
+	}
+	if len(pb.GetPlatforms()) > 0 {
+		t.Platforms = make(map[string]Message, len(pb.GetPlatforms()))
+		for platform, m := range pb.GetPlatforms() {
+			t.Platforms[platform] = newMessage(m.GetTitle(), m.GetContent(), m.GetClickAction(), m.GetData(), m.GetLocalized())
+		}
+	}
+	return t
+}
+
+func newMessage(title, content string, action *v1.ClickAction, data *structpb.Struct, localized map[string]*v1.LocalizedContent) Message {
+	m := Message{Title: title, Content: content}
+	if action != nil {
+		m.ClickAction = &ClickAction{
+			Action:     action.GetAction(),
+			Activity:   action.GetActivity(),
+			Url:        action.GetUrl(),
+			Parameters: action.GetParameters().AsMap(),
+		}
+	}
+	if len(data.GetFields()) > 0 {
+		m.Data = data.AsMap()
+	}
+	if len(localized) > 0 {
+		m.Localized = make(map[string]push.LocalizedContent, len(localized))
+		for l, c := range localized {
+			m.Localized[l] = push.LocalizedContent{Title: c.GetTitle(), Content: c.GetContent()}
+		}
+	}
+	return m
+}
+
+func (m Message) proto() (string, string, *v1.ClickAction, *structpb.Struct, map[string]*v1.LocalizedContent) {
+	var action *v1.ClickAction
+	if m.ClickAction != nil {
+		action = &v1.ClickAction{
+			Action:   m.ClickAction.Action,
+			Activity: m.ClickAction.Activity,
+			Url:      m.ClickAction.Url,
+		}
+		if len(m.ClickAction.Parameters) > 0 {
+			action.Parameters, _ = structpb.NewStruct(m.ClickAction.Parameters)
+		}
+	}
+	var data *structpb.Struct
+	if len(m.Data) > 0 {
+		data, _ = structpb.NewStruct(m.Data)
+	}
+	var localized map[string]*v1.LocalizedContent
+	if len(m.Localized) > 0 {
+		localized = make(map[string]*v1.LocalizedContent, len(m.Localized))
+		for l, c := range m.Localized {
+			localized[l] = &v1.LocalizedContent{Title: c.Title, Content: c.Content}
+		}
+	}
+	return m.Title, m.Content, action, data, localized
+}
+
+// merge 使用平台覆盖内容中不为空的字段替换默认内容，data 及 localized 按 key 合并
+func (m Message) merge(override Message) Message {
+	if override.Title != "" {
+		m.Title = override.Title
+	}
+	if override.Content != "" {
+		m.Content = override.Content
+	}
+	if override.ClickAction != nil {
+		m.ClickAction = override.ClickAction
+	}
+	if len(override.Data) > 0 {
+		data := make(map[string]interface{}, len(m.Data)+len(override.Data))
+		for k, v := range m.Data {
+			data[k] = v
+		}
+		for k, v := range override.Data {
+			data[k] = v
+		}
+		m.Data = data
+	}
+	if len(override.Localized) > 0 {
+		localized := make(map[string]push.LocalizedContent, len(m.Localized)+len(override.Localized))
+		for l, c := range m.Localized {
+			localized[l] = c
+		}
+		for l, c := range override.Localized {
+			localized[l] = c
+		}
+		m.Localized = localized
+	}
+	return m
+}
+
+func (m Message) validate() error {
+	if _, err := structpb.NewStruct(m.Data); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	strs := []string{m.Title, m.Content}
+	strs = appendStrings(strs, m.Data)
+	for _, c := range m.Localized {
+		strs = append(strs, c.Title, c.Content)
+	}
+	if m.ClickAction != nil {
+		strs = append(strs, m.ClickAction.Activity, m.ClickAction.Url)
+		strs = appendStrings(strs, m.ClickAction.Parameters)
+	}
+	for _, s := range strs {
+		if _, err := texttemplate.New("").Parse(s); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+		}
+	}
+	return nil
+}
+
+// appendStrings 收集 map 中所有的字符串值
+func appendStrings(strs []string, v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return append(strs, v)
+	case map[string]interface{}:
+		for _, e := range v {
+			strs = appendStrings(strs, e)
+		}
+	case []interface{}:
+		for _, e := range v {
+			strs = appendStrings(strs, e)
+		}
+	}
+	return strs
+}
+
+type renderer struct {
+	variables map[string]interface{}
+}
+
+func (r *renderer) render(s string) (string, error) {
+	if !strings.Contains(s, "{{") {
+		return s, nil
+	}
+	t, err := texttemplate.New("").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, r.variables); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return buf.String(), nil
+}
+
+// renderMap 渲染 map 中所有的字符串值，返回新的 map
+func (r *renderer) renderMap(m map[string]interface{}) (map[string]interface{}, error) {
+	if len(m) == 0 {
+		return m, nil
+	}
+	v, err := r.renderValue(m)
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string]interface{}), nil
+}
+
+func (r *renderer) renderValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		return r.render(v)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			rendered, err := r.renderValue(e)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = rendered
+		}
+		return m, nil
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, e := range v {
+			rendered, err := r.renderValue(e)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = rendered
+		}
+		return list, nil
+	}
+	return v, nil
+}
+
+// Store 消息模板存储
+type Store struct {
+	mutex sync.Mutex
+	store store.ObjectStore
+}
+
+func NewStore(store store.ObjectStore) *Store {
+	return &Store{store: store}
+}
+
+func (s *Store) Init() error {
+	return s.store.Init()
+}
+
+func (s *Store) Close() error {
+	if s == nil {
+		return nil
+	}
+	return s.store.Close()
+}
+
+// LoadDir 加载目录下的 yaml、json 模板文件，每个文件一个模板，模板未指定 id 时使用文件名作为 id，
+// 已存在的同名模板会被覆盖
+func (s *Store) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		t := &Template{}
+		// yaml 兼容 json 格式
+		if err := yaml.Unmarshal(data, t); err != nil {
+			return fmt.Errorf("load template %s: %w", entry.Name(), err)
+		}
+		if t.ID == "" {
+			t.ID = strings.TrimSuffix(entry.Name(), ext)
+		}
+		if _, err := s.Save(t); err != nil {
+			return fmt.Errorf("load template %s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+// Save 创建或更新模板
+func (s *Store) Save(t *Template) (*Template, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	t.CreatedAt = now
+	if old, err := s.get(t.ID); err == nil {
+		t.CreatedAt = old.CreatedAt
+	}
+	t.UpdatedAt = now
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Set(t.ID, data); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Get 查询模板
+func (s *Store) Get(id string) (*Template, error) {
+	if s == nil {
+		return nil, ErrTemplateNotFound
+	}
+	return s.get(id)
+}
+
+// List 查询所有模板，按 id 排序
+func (s *Store) List() []*Template {
+	if s == nil {
+		return nil
+	}
+	var templates []*Template
+	s.store.Range("", func(_ string, value []byte) bool {
+		t := &Template{}
+		if err := json.Unmarshal(value, t); err == nil {
+			templates = append(templates, t)
+		}
+		return true
+	})
+	return templates
+}
+
+// Delete 删除模板
+func (s *Store) Delete(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := s.get(id); err != nil {
+		return err
+	}
+	return s.store.Del(id)
+}
+
+func (s *Store) get(id string) (*Template, error) {
+	data, ok := s.store.Get(id)
+	if !ok {
+		return nil, ErrTemplateNotFound
+	}
+	t := &Template{}
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,105 @@
+package template
+
+import (
+	"errors"
+	"github.com/cossim/hipush/pkg/store"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTemplate(t *testing.T) {
+	tpl := &Template{
+		ID: "order_shipped",
+		Message: Message{
+			Title:       "Hi {{.name}}",
+			Content:     "Order {{.order}} shipped",
+			ClickAction: &ClickAction{Url: "https://example.com/orders/{{.order}}"},
+			Data:        map[string]interface{}{"order": "{{.order}}", "type": "order"},
+		},
+		Platforms: map[string]Message{
+			"ios": {Title: "{{.name}}, your order shipped", Data: map[string]interface{}{"badge": 1}},
+		},
+	}
+	if err := tpl.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	variables := map[string]interface{}{"name": "cossim", "order": "1001"}
+
+	// Test default message
+	m, err := tpl.Render("android", variables)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if m.Title != "Hi cossim" || m.Content != "Order 1001 shipped" || m.ClickAction.Url != "https://example.com/orders/1001" || m.Data["order"] != "1001" {
+		t.Errorf("Render failed: unexpected message %+v", m)
+	}
+
+	// Test platform override
+	m, err = tpl.Render("ios", variables)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if m.Title != "cossim, your order shipped" || m.Content != "Order 1001 shipped" || m.Data["badge"] != 1 || m.Data["type"] != "order" {
+		t.Errorf("Render ios failed: unexpected message %+v", m)
+	}
+	if tpl.Data["order"] != "{{.order}}" {
+		t.Errorf("Render modified the template data: %+v", tpl.Data)
+	}
+
+	// Test missing variable
+	if _, err := tpl.Render("android", map[string]interface{}{"name": "cossim"}); !errors.Is(err, ErrInvalidTemplate) {
+		t.Errorf("Render missing variable: expected ErrInvalidTemplate but got %v", err)
+	}
+
+	// Test validation
+	for _, invalid := range []*Template{
+		{Message: Message{Title: "x"}},
+		{ID: "a", Message: Message{Title: "{{.name"}},
+		{ID: "a", Platforms: map[string]Message{"unknown": {Title: "x"}}},
+	} {
+		if err := invalid.Validate(); !errors.Is(err, ErrInvalidTemplate) {
+			t.Errorf("Validate %+v: expected ErrInvalidTemplate but got %v", invalid, err)
+		}
+	}
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "welcome.yaml"), []byte("title: Welcome {{.name}}\nplatforms:\n  xiaomi:\n    content: hello\n"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	s := NewStore(store.NewMemoryObjectStore())
+	if err := s.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// Test load templates from directory, file name is used as id
+	if err := s.LoadDir(dir); err != nil {
+		t.Fatalf("LoadDir failed: %v", err)
+	}
+	tpl, err := s.Get("welcome")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if tpl.Title != "Welcome {{.name}}" || tpl.Platforms["xiaomi"].Content != "hello" {
+		t.Errorf("LoadDir failed: unexpected template %+v", tpl)
+	}
+
+	// Test proto conversion
+	if got := FromProto(tpl.Proto()); got.Title != tpl.Title || got.Platforms["xiaomi"].Content != "hello" {
+		t.Errorf("Proto failed: unexpected template %+v", got)
+	}
+
+	if err := s.Delete("welcome"); err != nil {
+		t.Errorf("Delete failed: %v", err)
+	}
+	if _, err := s.Get("welcome"); err != ErrTemplateNotFound {
+		t.Errorf("Delete failed: expected ErrTemplateNotFound but got %v", err)
+	}
+	if len(s.List()) != 0 {
+		t.Errorf("List failed: unexpected templates %+v", s.List())
+	}
+}
@@ -0,0 +1,369 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/consts"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PushBreakers 厂商接口的熔断器，未初始化时不进行熔断
+var PushBreakers *Breakers
+
+// 熔断器状态
+const (
+	StateClosed   = "closed"
+	StateOpen     = "open"
+	StateHalfOpen = "half_open"
+)
+
+var defaultConfig = config.BreakerConfig{
+	ConsecutiveFailures: 5,
+	ErrorRate:           0.5,
+	MinRequests:         20,
+	Window:              60,
+	OpenTimeout:         30,
+	HalfOpenRequests:    1,
+}
+
+func InitPushBreakers(cfg *config.Config) {
+	PushBreakers = New(cfg.CircuitBreaker)
+
+	// 预先创建已启用应用的熔断器，便于查询所有应用的状态
+	for _, app := range cfg.IOS {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformIOS.String(), app.AppID)
+		}
+	}
+	for _, app := range cfg.Android {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformAndroid.String(), app.AppID)
+		}
+	}
+	for _, app := range cfg.Huawei {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformHuawei.String(), app.AppID)
+		}
+	}
+	for _, app := range cfg.Xiaomi {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformXiaomi.String(), app.AppID)
+		}
+	}
+	for _, app := range cfg.Vivo {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformVivo.String(), app.AppID)
+		}
+	}
+	for _, app := range cfg.Oppo {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformOppo.String(), app.AppID)
+		}
+	}
+	for _, app := range cfg.Meizu {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformMeizu.String(), app.AppID)
+		}
+	}
+	for _, app := range cfg.Honor {
+		if app.Enabled {
+			PushBreakers.Get(consts.PlatformHonor.String(), app.AppID)
+		}
+	}
+}
+
+// State 应用熔断器的当前状态
+type State struct {
+	Platform string `json:"platform"`
+	AppID    string `json:"app_id"`
+	State    string `json:"state"`
+	// Requests、Failures 当前统计窗口内的请求数及失败数
+	Requests            int `json:"requests"`
+	Failures            int `json:"failures"`
+	ConsecutiveFailures int `json:"consecutive_failures"`
+	// OpenedAt 最近一次熔断的时间，未熔断过时为零值
+	OpenedAt time.Time `json:"opened_at"`
+}
+
+// Breakers 按平台配置、按应用创建的熔断器，每个应用对应一个厂商接口的客户端
+type Breakers struct {
+	mutex    sync.Mutex
+	configs  map[string]config.BreakerConfig
+	breakers map[string]*Breaker
+}
+
+// New 创建熔断器，只有 cfg 中配置的平台会启用熔断
+func New(cfg map[string]config.BreakerConfig) *Breakers {
+	b := &Breakers{
+		configs:  make(map[string]config.BreakerConfig, len(cfg)),
+		breakers: make(map[string]*Breaker),
+	}
+	for platform, c := range cfg {
+		b.configs[platform] = withDefaults(c)
+	}
+	return b
+}
+
+// Get 获取应用的熔断器，平台未启用熔断时返回 nil
+func (b *Breakers) Get(platform, appID string) *Breaker {
+	if b == nil {
+		return nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	c, ok := b.configs[platform]
+	if !ok {
+		return nil
+	}
+	key := platform + "/" + appID
+	br, ok := b.breakers[key]
+	if !ok {
+		br = NewBreaker(platform, appID, c)
+		b.breakers[key] = br
+	}
+	return br
+}
+
+// Check 熔断器处于打开状态时返回 push.ErrCircuitOpen，不占用半开状态的探测额度
+func (b *Breakers) Check(platform, appID string) error {
+	return b.Get(platform, appID).Check()
+}
+
+// Allow 判断是否允许调用厂商接口，允许时调用结束后需要通过 done 上报调用结果，
+// done 的参数为 nil 表示调用成功，ctx 取消或超时的调用不计入统计
+func (b *Breakers) Allow(platform, appID string) (done func(err error), err error) {
+	return b.Get(platform, appID).Allow()
+}
+
+// States 返回所有应用熔断器的当前状态
+func (b *Breakers) States() []State {
+	if b == nil {
+		return nil
+	}
+
+	b.mutex.Lock()
+	breakers := make([]*Breaker, 0, len(b.breakers))
+	for _, br := range b.breakers {
+		breakers = append(breakers, br)
+	}
+	b.mutex.Unlock()
+
+	states := make([]State, 0, len(breakers))
+	for _, br := range breakers {
+		states = append(states, br.State())
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].Platform != states[j].Platform {
+			return states[i].Platform < states[j].Platform
+		}
+		return states[i].AppID < states[j].AppID
+	})
+	return states
+}
+
+// Breaker 单个厂商接口的熔断器。
+// 关闭状态下连续失败次数或统计窗口内的失败率达到阈值时打开，打开状态下快速失败所有请求，
+// 经过 openTimeout 后进入半开状态，允许少量探测请求，探测全部成功后关闭，任一失败则重新打开
+type Breaker struct {
+	platform string
+	appID    string
+
+	consecutiveFailures int
+	errorRate           float64
+	minRequests         int
+	window              time.Duration
+	openTimeout         time.Duration
+	halfOpenRequests    int
+
+	mutex    sync.Mutex
+	state    string
+	openedAt time.Time
+	// generation 每次状态变化时加一，忽略状态变化前发出的请求的结果
+	generation  uint64
+	windowStart time.Time
+	requests    int
+	failures    int
+	consecutive int
+	// probes、successes 半开状态下已发出的探测请求数及成功数
+	probes    int
+	successes int
+}
+
+func NewBreaker(platform, appID string, cfg config.BreakerConfig) *Breaker {
+	cfg = withDefaults(cfg)
+	return &Breaker{
+		platform:            platform,
+		appID:               appID,
+		consecutiveFailures: cfg.ConsecutiveFailures,
+		errorRate:           cfg.ErrorRate,
+		minRequests:         cfg.MinRequests,
+		window:              time.Duration(cfg.Window) * time.Second,
+		openTimeout:         time.Duration(cfg.OpenTimeout) * time.Second,
+		halfOpenRequests:    cfg.HalfOpenRequests,
+		state:               StateClosed,
+		windowStart:         time.Now(),
+	}
+}
+
+func (b *Breaker) Check() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.refresh(time.Now())
+	if b.state == StateOpen {
+		return b.openError()
+	}
+	return nil
+}
+
+func (b *Breaker) Allow() (done func(err error), err error) {
+	if b == nil {
+		return func(error) {}, nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.refresh(time.Now())
+	switch b.state {
+	case StateOpen:
+		return nil, b.openError()
+	case StateHalfOpen:
+		if b.probes >= b.halfOpenRequests {
+			return nil, b.openError()
+		}
+		b.probes++
+	}
+
+	generation := b.generation
+	return func(err error) {
+		b.done(generation, err)
+	}, nil
+}
+
+func (b *Breaker) State() State {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.refresh(time.Now())
+	return State{
+		Platform:            b.platform,
+		AppID:               b.appID,
+		State:               b.state,
+		Requests:            b.requests,
+		Failures:            b.failures,
+		ConsecutiveFailures: b.consecutive,
+		OpenedAt:            b.openedAt,
+	}
+}
+
+func (b *Breaker) done(generation uint64, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if generation != b.generation {
+		return
+	}
+
+	now := time.Now()
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// 释放探测额度，避免半开状态下没有请求可以探测
+		if b.state == StateHalfOpen {
+			b.probes--
+		}
+		return
+	}
+	success := err == nil
+	b.refresh(now)
+	switch b.state {
+	case StateClosed:
+		b.requests++
+		if success {
+			b.consecutive = 0
+			return
+		}
+		b.failures++
+		b.consecutive++
+		if b.consecutive >= b.consecutiveFailures ||
+			(b.requests >= b.minRequests && float64(b.failures)/float64(b.requests) >= b.errorRate) {
+			b.setState(StateOpen, now)
+		}
+	case StateHalfOpen:
+		if !success {
+			b.setState(StateOpen, now)
+			return
+		}
+		b.successes++
+		if b.successes >= b.halfOpenRequests {
+			b.setState(StateClosed, now)
+		}
+	}
+}
+
+// refresh 打开状态超过 openTimeout 后进入半开状态，关闭状态下统计窗口结束后重新计数，调用方需持有锁
+func (b *Breaker) refresh(now time.Time) {
+	switch b.state {
+	case StateOpen:
+		if now.Sub(b.openedAt) >= b.openTimeout {
+			b.setState(StateHalfOpen, now)
+		}
+	case StateClosed:
+		if now.Sub(b.windowStart) >= b.window {
+			b.windowStart = now
+			b.requests = 0
+			b.failures = 0
+		}
+	}
+}
+
+// setState 切换状态并重置计数，调用方需持有锁
+func (b *Breaker) setState(state string, now time.Time) {
+	log.Printf("circuit breaker of %s/%s changed from %s to %s", b.platform, b.appID, b.state, state)
+	b.state = state
+	b.generation++
+	b.probes = 0
+	b.successes = 0
+	switch state {
+	case StateOpen:
+		b.openedAt = now
+	case StateClosed:
+		b.windowStart = now
+		b.requests = 0
+		b.failures = 0
+		b.consecutive = 0
+	}
+}
+
+func (b *Breaker) openError() error {
+	return fmt.Errorf("%w: %s", push.ErrCircuitOpen, strings.TrimSuffix(b.platform+"/"+b.appID, "/"))
+}
+
+func withDefaults(cfg config.BreakerConfig) config.BreakerConfig {
+	if cfg.ConsecutiveFailures <= 0 {
+		cfg.ConsecutiveFailures = defaultConfig.ConsecutiveFailures
+	}
+	if cfg.ErrorRate <= 0 || cfg.ErrorRate > 1 {
+		cfg.ErrorRate = defaultConfig.ErrorRate
+	}
+	if cfg.MinRequests <= 0 {
+		cfg.MinRequests = defaultConfig.MinRequests
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = defaultConfig.Window
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = defaultConfig.OpenTimeout
+	}
+	if cfg.HalfOpenRequests <= 0 {
+		cfg.HalfOpenRequests = defaultConfig.HalfOpenRequests
+	}
+	return cfg
+}
@@ -0,0 +1,87 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	b := NewBreaker("huawei", "app1", config.BreakerConfig{ConsecutiveFailures: 2, HalfOpenRequests: 1})
+	b.openTimeout = 10 * time.Millisecond
+	failure := errors.New("service unavailable")
+
+	// Test consecutive failures open the breaker
+	for i := 0; i < 2; i++ {
+		done, err := b.Allow()
+		if err != nil {
+			t.Fatalf("Allow failed: %v", err)
+		}
+		done(failure)
+	}
+	if s := b.State(); s.State != StateOpen {
+		t.Errorf("State failed: expected %s but got %s", StateOpen, s.State)
+	}
+	if _, err := b.Allow(); !errors.Is(err, push.ErrCircuitOpen) {
+		t.Errorf("Allow failed: expected ErrCircuitOpen but got %v", err)
+	}
+	if err := b.Check(); !errors.Is(err, push.ErrCircuitOpen) {
+		t.Errorf("Check failed: expected ErrCircuitOpen but got %v", err)
+	}
+
+	// Test half-open allows a single probe and a failed probe reopens the breaker
+	time.Sleep(20 * time.Millisecond)
+	done, err := b.Allow()
+	if err != nil {
+		t.Fatalf("Allow failed: expected probe to be allowed but got %v", err)
+	}
+	if _, err := b.Allow(); !errors.Is(err, push.ErrCircuitOpen) {
+		t.Errorf("Allow failed: expected second probe to be rejected but got %v", err)
+	}
+	done(failure)
+	if s := b.State(); s.State != StateOpen {
+		t.Errorf("State failed: expected %s but got %s", StateOpen, s.State)
+	}
+
+	// Test cancelled probe releases the probe and a successful probe closes the breaker
+	time.Sleep(20 * time.Millisecond)
+	done, _ = b.Allow()
+	done(context.Canceled)
+	done, err = b.Allow()
+	if err != nil {
+		t.Fatalf("Allow failed: expected probe to be released but got %v", err)
+	}
+	done(nil)
+	if s := b.State(); s.State != StateClosed || s.ConsecutiveFailures != 0 {
+		t.Errorf("State failed: unexpected state %+v", s)
+	}
+
+	// Test error rate opens the breaker
+	b = NewBreaker("huawei", "app1", config.BreakerConfig{ConsecutiveFailures: 100, ErrorRate: 0.5, MinRequests: 4})
+	for i := 0; i < 4; i++ {
+		done, _ := b.Allow()
+		if i%2 == 0 {
+			done(nil)
+		} else {
+			done(failure)
+		}
+	}
+	if s := b.State(); s.State != StateOpen {
+		t.Errorf("State failed: expected error rate to open the breaker but got %s", s.State)
+	}
+
+	// Test platform without config is not limited
+	breakers := New(map[string]config.BreakerConfig{"huawei": {}})
+	if breakers.Get("honor", "app1") != nil {
+		t.Errorf("Get failed: expected no breaker for honor")
+	}
+	if _, err := breakers.Allow("honor", "app1"); err != nil {
+		t.Errorf("Allow failed: %v", err)
+	}
+	if states := breakers.States(); len(states) != 0 {
+		t.Errorf("States failed: expected no states but got %d", len(states))
+	}
+}
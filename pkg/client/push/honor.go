@@ -6,16 +6,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 )
 
+// 荣耀推送鉴权失败的返回码，需要重新获取 access token
+const (
+	HonorTokenFailedCode  = 80200001
+	HonorTokenTimeoutCode = 80200003
+)
+
 type HonorPushClient struct {
 	ClientID     string
 	ClientSecret string
-	AccessToken  string
 	pushUrl      string
 	authUrl      string
 	httpClient   *http.Client
+
+	// mutex 保护 accessToken，同一个客户端会被并发推送使用
+	mutex       sync.Mutex
+	accessToken string
 }
 
 type SendMessageRequest struct {
@@ -129,20 +139,29 @@ func NewHonorPush(clientID, clientSecret string, options ...HonorPushOption) *Ho
 	return sdk
 }
 
-// 获取Access Token
-func (hpc *HonorPushClient) getAccessToken(ctx context.Context) error {
+// 获取Access Token，已获取时直接返回
+func (hpc *HonorPushClient) getAccessToken(ctx context.Context) (string, error) {
+	hpc.mutex.Lock()
+	defer hpc.mutex.Unlock()
+	if hpc.accessToken != "" {
+		return hpc.accessToken, nil
+	}
+
 	requestBody := fmt.Sprintf("grant_type=client_credentials&client_id=%s&client_secret=%s", hpc.ClientID, hpc.ClientSecret)
 	req, err := http.NewRequestWithContext(ctx, "POST", hpc.authUrl, bytes.NewBufferString(requestBody))
 	if err != nil {
-		return err
+		return "", err
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := hpc.httpClient.Do(req)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", newHTTPError(resp)
+	}
 
 	var tokenResponse struct {
 		AccessToken string `json:"access_token"`
@@ -150,20 +169,28 @@ func (hpc *HonorPushClient) getAccessToken(ctx context.Context) error {
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
-		return err
+		return "", err
 	}
 
-	hpc.AccessToken = tokenResponse.AccessToken
+	hpc.accessToken = tokenResponse.AccessToken
+
+	return hpc.accessToken, nil
+}
 
-	return nil
+// resetAccessToken access token 失效后清空，下次推送时重新获取
+func (hpc *HonorPushClient) resetAccessToken(token string) {
+	hpc.mutex.Lock()
+	defer hpc.mutex.Unlock()
+	if hpc.accessToken == token {
+		hpc.accessToken = ""
+	}
 }
 
 // SendMessage 方法用于推送消息
 func (hpc *HonorPushClient) SendMessage(ctx context.Context, appID string, requestBody *SendMessageRequest) (*SendMessageResponse, error) {
-	if hpc.AccessToken == "" {
-		if err := hpc.getAccessToken(ctx); err != nil {
-			return nil, err
-		}
+	accessToken, err := hpc.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	requestBodyJSON, err := json.Marshal(requestBody)
@@ -177,7 +204,7 @@ func (hpc *HonorPushClient) SendMessage(ctx context.Context, appID string, reque
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+hpc.AccessToken)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
 	req.Header.Set("timestamp", fmt.Sprintf("%d", time.Now().Unix()))
 
 	resp, err := hpc.httpClient.Do(req)
@@ -186,12 +213,27 @@ func (hpc *HonorPushClient) SendMessage(ctx context.Context, appID string, reque
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		hpc.resetAccessToken(accessToken)
+	}
+	if resp.StatusCode != http.StatusOK {
+		httpErr := newHTTPError(resp)
+		var response SendMessageResponse
+		if err := json.NewDecoder(resp.Body).Decode(&response); err == nil && response.Message != "" {
+			return &response, fmt.Errorf("%w: %s", httpErr, response.Message)
+		}
+		return nil, httpErr
+	}
+
 	// 解析响应
 	var response SendMessageResponse
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	if err != nil {
 		return nil, err
 	}
+	if response.Code == HonorTokenFailedCode || response.Code == HonorTokenTimeoutCode {
+		hpc.resetAccessToken(accessToken)
+	}
 
 	return &response, nil
 }
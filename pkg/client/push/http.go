@@ -0,0 +1,43 @@
+package push
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// HTTPError 厂商接口返回的 HTTP 状态码不为 200
+type HTTPError struct {
+	StatusCode int
+	// RetryAfter 厂商通过 Retry-After 响应头建议的重试等待时间，没有时为 0
+	RetryAfter time.Duration
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("http status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func newHTTPError(resp *http.Response) *HTTPError {
+	return &HTTPError{StatusCode: resp.StatusCode, RetryAfter: ParseRetryAfter(resp.Header)}
+}
+
+// ParseRetryAfter 解析 Retry-After 响应头，支持秒数及 HTTP 日期两种格式
+func ParseRetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,162 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	auth "github.com/cossim/go-hms-push/push/authention"
+	"github.com/cossim/go-hms-push/push/config"
+	"net/http"
+	"sync"
+)
+
+// HMSTopicClient 华为主题管理接口，go-hms-push 未提供主题订阅相关的接口
+type HMSTopicClient struct {
+	appID      string
+	pushUrl    string
+	authClient *auth.AuthClient
+	httpClient *http.Client
+
+	mutex       sync.Mutex
+	accessToken string
+}
+
+// TopicRequest 订阅或取消订阅主题的请求
+type TopicRequest struct {
+	Topic      string   `json:"topic"`
+	TokenArray []string `json:"tokenArray"`
+}
+
+// TopicResponse 订阅或取消订阅主题的响应
+type TopicResponse struct {
+	Code         string        `json:"code"`
+	Msg          string        `json:"msg"`
+	RequestID    string        `json:"requestId"`
+	SuccessCount int           `json:"successCount"`
+	FailureCount int           `json:"failureCount"`
+	Errors       []*TopicError `json:"errors,omitempty"`
+}
+
+// TopicError 订阅失败的 token
+type TopicError struct {
+	Token     string `json:"token"`
+	ErrorCode string `json:"errorCode"`
+}
+
+// TopicListResponse 查询设备订阅主题的响应
+type TopicListResponse struct {
+	Code      string   `json:"code"`
+	Msg       string   `json:"msg"`
+	RequestID string   `json:"requestId"`
+	Topics    []*Topic `json:"topics,omitempty"`
+}
+
+type Topic struct {
+	Name    string `json:"name"`
+	AddDate string `json:"addDate"`
+}
+
+const (
+	hmsSuccessCode      = "80000000"
+	hmsTokenExpiredCode = "80200003"
+)
+
+func NewHMSTopicClient(c *config.Config) (*HMSTopicClient, error) {
+	authClient, err := auth.NewAuthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	return &HMSTopicClient{
+		appID:      c.AppId,
+		pushUrl:    c.PushUrl,
+		authClient: authClient,
+		httpClient: &http.Client{},
+	}, nil
+}
+
+// Subscribe 订阅主题，单次最多 1000 个 token
+func (c *HMSTopicClient) Subscribe(ctx context.Context, req *TopicRequest) (*TopicResponse, error) {
+	var resp TopicResponse
+	if err := c.do(ctx, "topic:subscribe", req, &resp, func() string { return resp.Code }); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Unsubscribe 取消订阅主题，单次最多 1000 个 token
+func (c *HMSTopicClient) Unsubscribe(ctx context.Context, req *TopicRequest) (*TopicResponse, error) {
+	var resp TopicResponse
+	if err := c.do(ctx, "topic:unsubscribe", req, &resp, func() string { return resp.Code }); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List 查询设备订阅的主题
+func (c *HMSTopicClient) List(ctx context.Context, token string) (*TopicListResponse, error) {
+	var resp TopicListResponse
+	body := map[string]string{"token": token}
+	if err := c.do(ctx, "topic:list", body, &resp, func() string { return resp.Code }); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// do 发送请求，access token 过期时刷新后重试一次
+func (c *HMSTopicClient) do(ctx context.Context, path string, body interface{}, resp interface{}, code func() string) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		token, err := c.getAccessToken(ctx, i > 0)
+		if err != nil {
+			return err
+		}
+		if err := c.post(ctx, path, token, data, resp); err != nil {
+			return err
+		}
+		if code() != hmsTokenExpiredCode {
+			break
+		}
+	}
+
+	if code() != hmsSuccessCode {
+		return fmt.Errorf("hms topic %s failed: code %s", path, code())
+	}
+	return nil
+}
+
+func (c *HMSTopicClient) post(ctx context.Context, path, token string, data []byte, resp interface{}) error {
+	url := fmt.Sprintf("%s/v1/%s/%s", c.pushUrl, c.appID, path)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json;charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return json.NewDecoder(res.Body).Decode(resp)
+}
+
+func (c *HMSTopicClient) getAccessToken(ctx context.Context, refresh bool) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.accessToken != "" && !refresh {
+		return c.accessToken, nil
+	}
+	token, err := c.authClient.GetAuthToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.accessToken = token
+	return token, nil
+}
@@ -0,0 +1,82 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cossim/go-hms-push/push/config"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestHMSTopicClient 测试主题接口的请求路径、鉴权及请求体，access token 过期时刷新后重试，以及厂商错误码
+func TestHMSTopicClient(t *testing.T) {
+	var mutex sync.Mutex
+	var tokens int
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if r.URL.Path == "/oauth2/token" {
+			tokens++
+			fmt.Fprintf(w, `{"access_token": "token%d", "expires_in": 3600}`, tokens)
+			return
+		}
+
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "Bearer token1" {
+			// 第一个 access token 已过期
+			fmt.Fprint(w, `{"code": "80200003", "msg": "OAuth token expired"}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/app/topic:subscribe":
+			req := &TopicRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil || req.Topic != "news" || !reflect.DeepEqual(req.TokenArray, []string{"a", "b"}) {
+				t.Errorf("unexpected subscribe request %+v, %v", req, err)
+			}
+			fmt.Fprint(w, `{"code": "80000000", "msg": "Success", "requestId": "1", "successCount": 1, "failureCount": 1, "errors": [{"token": "b", "errorCode": "80300007"}]}`)
+		case "/v1/app/topic:list":
+			fmt.Fprint(w, `{"code": "80000000", "msg": "Success", "topics": [{"name": "news", "addDate": "2024-01-01"}]}`)
+		default:
+			fmt.Fprint(w, `{"code": "80100001", "msg": "Some request parameters are invalid"}`)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewHMSTopicClient(&config.Config{AppId: "app", AppSecret: "secret", AuthUrl: server.URL + "/oauth2/token", PushUrl: server.URL})
+	if err != nil {
+		t.Fatalf("NewHMSTopicClient() error = %v", err)
+	}
+
+	// Test an expired access token is refreshed and the request is sent again
+	resp, err := c.Subscribe(context.Background(), &TopicRequest{Topic: "news", TokenArray: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	if resp.SuccessCount != 1 || resp.FailureCount != 1 || len(resp.Errors) != 1 || resp.Errors[0].Token != "b" {
+		t.Errorf("Subscribe() = %+v", resp)
+	}
+	if want := []string{"Bearer token1", "Bearer token2"}; !reflect.DeepEqual(authorizations, want) {
+		t.Errorf("Authorization = %v, want %v", authorizations, want)
+	}
+
+	list, err := c.List(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(list.Topics) != 1 || list.Topics[0].Name != "news" {
+		t.Errorf("List() = %+v", list)
+	}
+
+	// Test a vendor error code is returned as error
+	if _, err := c.Unsubscribe(context.Background(), &TopicRequest{Topic: "news"}); err == nil {
+		t.Errorf("Unsubscribe() error = nil, want vendor error")
+	}
+	if tokens != 2 {
+		t.Errorf("access token is requested %d times, want 2", tokens)
+	}
+}
@@ -0,0 +1,115 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// VivoTagClient vivo 标签管理及标签推送接口，vivo-push 未提供标签相关的接口，
+// 鉴权使用 vivo-push 获取的 authToken
+type VivoTagClient struct {
+	authToken  string
+	host       string
+	httpClient *http.Client
+}
+
+// VivoTagMembersRequest 添加或删除标签成员的请求
+type VivoTagMembersRequest struct {
+	Name string `json:"name"`
+	// Type 1 表示 ids 为 regId
+	Type int      `json:"type"`
+	Ids  []string `json:"ids"`
+}
+
+// VivoTagPushRequest 标签推送请求
+type VivoTagPushRequest struct {
+	NotifyType      int                `json:"notifyType"`
+	Title           string             `json:"title"`
+	Content         string             `json:"content"`
+	TimeToLive      int64              `json:"timeToLive,omitempty"`
+	SkipType        int                `json:"skipType"`
+	SkipContent     string             `json:"skipContent,omitempty"`
+	NetworkType     int                `json:"networkType,omitempty"`
+	Classification  int                `json:"classification,omitempty"`
+	ClientCustomMap map[string]string  `json:"clientCustomMap,omitempty"`
+	PushMode        int                `json:"pushMode,omitempty"`
+	RequestId       string             `json:"requestId"`
+	TagExpression   *VivoTagExpression `json:"tagExpression"`
+}
+
+// VivoTagExpression 标签表达式
+type VivoTagExpression struct {
+	OrTags  []string `json:"orTags,omitempty"`
+	AndTags []string `json:"andTags,omitempty"`
+	NotTags []string `json:"notTags,omitempty"`
+}
+
+// VivoTagResponse 标签接口的响应
+type VivoTagResponse struct {
+	Result int    `json:"result"`
+	Desc   string `json:"desc"`
+	TaskId string `json:"taskId,omitempty"`
+}
+
+// VivoTagMemberType 按 regId 管理标签成员
+const VivoTagMemberType = 1
+
+func NewVivoTagClient(authToken string) *VivoTagClient {
+	return &VivoTagClient{
+		authToken:  authToken,
+		host:       "https://api-push.vivo.com.cn",
+		httpClient: &http.Client{},
+	}
+}
+
+// AddTag 创建标签，标签已存在时 vivo 会返回错误，调用方可以忽略
+func (c *VivoTagClient) AddTag(ctx context.Context, name string) (*VivoTagResponse, error) {
+	return c.post(ctx, "/tag/add", map[string]string{"name": name})
+}
+
+// AddMembers 为标签添加成员
+func (c *VivoTagClient) AddMembers(ctx context.Context, req *VivoTagMembersRequest) (*VivoTagResponse, error) {
+	return c.post(ctx, "/tag/addMembers", req)
+}
+
+// RemoveMembers 删除标签成员
+func (c *VivoTagClient) RemoveMembers(ctx context.Context, req *VivoTagMembersRequest) (*VivoTagResponse, error) {
+	return c.post(ctx, "/tag/removeMembers", req)
+}
+
+// TagPush 按标签推送
+func (c *VivoTagClient) TagPush(ctx context.Context, req *VivoTagPushRequest) (*VivoTagResponse, error) {
+	return c.post(ctx, "/message/tagPush", req)
+}
+
+func (c *VivoTagClient) post(ctx context.Context, path string, body interface{}) (*VivoTagResponse, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.host+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("authToken", c.authToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newHTTPError(resp)
+	}
+
+	var response VivoTagResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
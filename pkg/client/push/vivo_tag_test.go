@@ -0,0 +1,61 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestVivoTagClient 测试标签接口的请求路径、鉴权及请求体，以及厂商错误码和 HTTP 错误
+func TestVivoTagClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("authToken") != "token" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		switch r.URL.Path {
+		case "/tag/addMembers":
+			req := &VivoTagMembersRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil || req.Name != "news" || req.Type != VivoTagMemberType || !reflect.DeepEqual(req.Ids, []string{"a", "b"}) {
+				t.Errorf("unexpected addMembers request %+v, %v", req, err)
+			}
+			fmt.Fprint(w, `{"result": 0, "desc": "请求成功"}`)
+		case "/message/tagPush":
+			req := &VivoTagPushRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil || req.TagExpression == nil || !reflect.DeepEqual(req.TagExpression.OrTags, []string{"news"}) {
+				t.Errorf("unexpected tagPush request %+v, %v", req, err)
+			}
+			fmt.Fprint(w, `{"result": 0, "desc": "请求成功", "taskId": "task"}`)
+		case "/tag/add":
+			fmt.Fprint(w, `{"result": 10070, "desc": "标签已存在"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := NewVivoTagClient("token")
+	c.host = server.URL
+
+	resp, err := c.AddMembers(context.Background(), &VivoTagMembersRequest{Name: "news", Type: VivoTagMemberType, Ids: []string{"a", "b"}})
+	if err != nil || resp.Result != 0 {
+		t.Errorf("AddMembers() = %+v, %v", resp, err)
+	}
+	resp, err = c.TagPush(context.Background(), &VivoTagPushRequest{Title: "title", Content: "content", RequestId: "1", TagExpression: &VivoTagExpression{OrTags: []string{"news"}}})
+	if err != nil || resp.Result != 0 || resp.TaskId != "task" {
+		t.Errorf("TagPush() = %+v, %v", resp, err)
+	}
+
+	// Test a vendor error code is returned in the response for the caller to handle
+	resp, err = c.AddTag(context.Background(), "news")
+	if err != nil || resp.Result != 10070 || resp.Desc != "标签已存在" {
+		t.Errorf("AddTag() = %+v, %v", resp, err)
+	}
+
+	if _, err := c.RemoveMembers(context.Background(), &VivoTagMembersRequest{Name: "news"}); err == nil {
+		t.Errorf("RemoveMembers() error = nil, want HTTP error")
+	}
+}
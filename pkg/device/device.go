@@ -0,0 +1,319 @@
+package device
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/store"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PushDevices 设备注册表，记录用户的设备，推送时根据用户id查找设备
+var PushDevices *Registry
+
+var (
+	ErrDeviceNotFound  = errors.New("device not found")
+	ErrInvalidDevice   = errors.New("user_id, platform, app_id and token are required")
+	ErrInvalidPlatform = errors.New("invalid platform")
+)
+
+func InitPushDevices(cfg *config.Config) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "devices")
+	if err != nil {
+		return err
+	}
+
+	PushDevices = NewRegistry(s, feedback.InvalidTokenStorage)
+	return PushDevices.Init()
+}
+
+// Device 用户注册的设备
+type Device struct {
+	UserID   string `json:"user_id"`
+	Platform string `json:"platform"`
+	AppID    string `json:"app_id"`
+	Token    string `json:"token"`
+	// Locale 设备的语言，例如 zh-CN
+	Locale string `json:"locale,omitempty"`
+	// Timezone 设备的时区，例如 Asia/Shanghai
+	Timezone   string `json:"timezone,omitempty"`
+	AppVersion string `json:"app_version,omitempty"`
+	// Tags 设备的标签，用于按分群推送
+	Tags []string `json:"tags,omitempty"`
+	// Attributes 设备的自定义属性，用于按分群推送
+	Attributes map[string]string `json:"attributes,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// Target 用户设备按平台及应用分组后的推送目标
+type Target struct {
+	Platform string
+	AppID    string
+	Tokens   []string
+}
+
+// Query 查找设备的条件，UserIDs 为空时查找所有用户的设备，Platform、AppID 为空时不进行过滤，
+// Segment 不为 nil 时只查找匹配分群表达式的设备
+type Query struct {
+	UserIDs  []string
+	Platform string
+	AppID    string
+	Segment  *Segment
+}
+
+// Registry 设备注册表，设备以 device/platform/appID/token 为 key 保存，
+// 同时以 user/userID/platform/appID/token 为 key 保存用户的设备索引
+type Registry struct {
+	mutex   sync.Mutex
+	store   store.ObjectStore
+	invalid *feedback.Storage
+}
+
+// NewRegistry 创建设备注册表，invalid 不为 nil 时查找设备会跳过厂商反馈已失效的 token
+func NewRegistry(store store.ObjectStore, invalid *feedback.Storage) *Registry {
+	return &Registry{store: store, invalid: invalid}
+}
+
+func (r *Registry) Init() error {
+	return r.store.Init()
+}
+
+func (r *Registry) Close() error {
+	if r == nil {
+		return nil
+	}
+	return r.store.Close()
+}
+
+// Register 注册或更新设备，token 已注册到其他用户时转移到新用户，
+// 更新设备时未指定标签或属性则保留原有的标签或属性
+func (r *Registry) Register(d Device) (Device, error) {
+	if d.UserID == "" || d.Platform == "" || d.AppID == "" || d.Token == "" {
+		return d, ErrInvalidDevice
+	}
+	if !consts.Platform(d.Platform).IsValid() {
+		return d, ErrInvalidPlatform
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	d.CreatedAt = now
+	if old, ok := r.get(d.Platform, d.AppID, d.Token); ok {
+		d.CreatedAt = old.CreatedAt
+		if d.Tags == nil {
+			d.Tags = old.Tags
+		}
+		if d.Attributes == nil {
+			d.Attributes = old.Attributes
+		}
+		if old.UserID != d.UserID {
+			r.store.Del(userKey(old))
+		}
+	}
+	d.UpdatedAt = now
+	return d, r.save(d)
+}
+
+// Unregister 删除设备
+func (r *Registry) Unregister(platform, appID, token string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	d, ok := r.get(platform, appID, token)
+	if !ok {
+		return ErrDeviceNotFound
+	}
+	if err := r.store.Del(deviceKey(d.Platform, d.AppID, d.Token)); err != nil {
+		return err
+	}
+	return r.store.Del(userKey(d))
+}
+
+// Refresh 厂商刷新设备 token 后使用新 token 替换旧 token，保留设备的其他信息
+func (r *Registry) Refresh(platform, appID, oldToken, newToken string) (Device, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	d, ok := r.get(platform, appID, oldToken)
+	if !ok {
+		return d, ErrDeviceNotFound
+	}
+	if newToken == "" {
+		return d, ErrInvalidDevice
+	}
+	if err := r.store.Del(deviceKey(d.Platform, d.AppID, d.Token)); err != nil {
+		return d, err
+	}
+	if err := r.store.Del(userKey(d)); err != nil {
+		return d, err
+	}
+	if old, ok := r.get(platform, appID, newToken); ok && old.UserID != d.UserID {
+		r.store.Del(userKey(old))
+	}
+	d.Token = newToken
+	d.UpdatedAt = time.Now()
+	return d, r.save(d)
+}
+
+// Tag 更新设备的标签及属性，tags 不为 nil 时替换设备的所有标签，
+// attributes 中的属性合并到设备的属性中，值为空时删除该属性
+func (r *Registry) Tag(platform, appID, token string, tags []string, attributes map[string]string) (Device, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	d, ok := r.get(platform, appID, token)
+	if !ok {
+		return d, ErrDeviceNotFound
+	}
+	if tags != nil {
+		d.Tags = tags
+	}
+	for k, v := range attributes {
+		if v == "" {
+			delete(d.Attributes, k)
+			continue
+		}
+		if d.Attributes == nil {
+			d.Attributes = make(map[string]string)
+		}
+		d.Attributes[k] = v
+	}
+	d.UpdatedAt = time.Now()
+	return d, r.save(d)
+}
+
+// Get 查询设备
+func (r *Registry) Get(platform, appID, token string) (Device, bool) {
+	if r == nil {
+		return Device{}, false
+	}
+	return r.get(platform, appID, token)
+}
+
+// List 查询设备，userID 不为空时只查询该用户的设备，platform 和 appID 为空时不进行过滤
+func (r *Registry) List(userID, platform, appID string) []Device {
+	if r == nil {
+		return nil
+	}
+
+	prefix := "device/"
+	if userID != "" {
+		prefix = "user/" + userID + "/"
+	}
+	var devices []Device
+	r.store.Range(prefix, func(_ string, value []byte) bool {
+		var d Device
+		if err := json.Unmarshal(value, &d); err != nil {
+			return true
+		}
+		if userID != "" && d.UserID != userID {
+			return true
+		}
+		if (platform == "" || d.Platform == platform) && (appID == "" || d.AppID == appID) {
+			devices = append(devices, d)
+		}
+		return true
+	})
+	return devices
+}
+
+// Find 查找匹配条件的设备，注册之后厂商反馈已失效的 token 不会返回
+func (r *Registry) Find(q Query) []Device {
+	if r == nil {
+		return nil
+	}
+
+	var candidates []Device
+	if len(q.UserIDs) == 0 {
+		candidates = r.List("", q.Platform, q.AppID)
+	}
+	for _, userID := range q.UserIDs {
+		if userID != "" {
+			candidates = append(candidates, r.List(userID, q.Platform, q.AppID)...)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var devices []Device
+	for _, d := range candidates {
+		key := deviceKey(d.Platform, d.AppID, d.Token)
+		if seen[key] || !q.Segment.Match(d) || r.isInvalid(d) {
+			continue
+		}
+		seen[key] = true
+		devices = append(devices, d)
+	}
+	return devices
+}
+
+// Resolve 查找匹配条件的设备并按平台及应用分组
+func (r *Registry) Resolve(q Query) []Target {
+	targets := make(map[string]*Target)
+	for _, d := range r.Find(q) {
+		group := d.Platform + "/" + d.AppID
+		t, ok := targets[group]
+		if !ok {
+			t = &Target{Platform: d.Platform, AppID: d.AppID}
+			targets[group] = t
+		}
+		t.Tokens = append(t.Tokens, d.Token)
+	}
+
+	result := make([]Target, 0, len(targets))
+	for _, t := range targets {
+		result = append(result, *t)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Platform != result[j].Platform {
+			return result[i].Platform < result[j].Platform
+		}
+		return result[i].AppID < result[j].AppID
+	})
+	return result
+}
+
+func (r *Registry) isInvalid(d Device) bool {
+	t, ok := r.invalid.Get(d.Platform, d.AppID, d.Token)
+	return ok && t.CreatedAt.After(d.UpdatedAt)
+}
+
+// get 查询设备，修改设备时调用方需持有锁
+func (r *Registry) get(platform, appID, token string) (Device, bool) {
+	var d Device
+	data, ok := r.store.Get(deviceKey(platform, appID, token))
+	if !ok {
+		return d, false
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return d, false
+	}
+	return d, true
+}
+
+// save 保存设备及用户的设备索引，调用方需持有锁
+func (r *Registry) save(d Device) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	if err := r.store.Set(deviceKey(d.Platform, d.AppID, d.Token), data); err != nil {
+		return err
+	}
+	return r.store.Set(userKey(d), data)
+}
+
+func deviceKey(platform, appID, token string) string {
+	return strings.Join([]string{"device", platform, appID, token}, "/")
+}
+
+func userKey(d Device) string {
+	return strings.Join([]string{"user", d.UserID, d.Platform, d.AppID, d.Token}, "/")
+}
@@ -0,0 +1,95 @@
+package device
+
+import (
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/store"
+	"testing"
+	"time"
+)
+
+func TestRegistry(t *testing.T) {
+	invalid := feedback.NewStorage(store.NewMemoryObjectStore(), nil)
+	r := NewRegistry(store.NewMemoryObjectStore(), invalid)
+	if err := r.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// Test register validation
+	if _, err := r.Register(Device{UserID: "u1", Platform: "ios", AppID: "app"}); err != ErrInvalidDevice {
+		t.Errorf("Register failed: expected ErrInvalidDevice but got %v", err)
+	}
+	if _, err := r.Register(Device{UserID: "u1", Platform: "unknown", AppID: "app", Token: "a"}); err != ErrInvalidPlatform {
+		t.Errorf("Register failed: expected ErrInvalidPlatform but got %v", err)
+	}
+
+	for _, d := range []Device{
+		{UserID: "u1", Platform: "ios", AppID: "app", Token: "a"},
+		{UserID: "u1", Platform: "xiaomi", AppID: "app", Token: "b"},
+		{UserID: "u2", Platform: "ios", AppID: "app", Token: "c"},
+	} {
+		if _, err := r.Register(d); err != nil {
+			t.Fatalf("Register failed: %v", err)
+		}
+	}
+
+	// Test resolve groups devices by platform and app
+	targets := r.Resolve(Query{UserIDs: []string{"u1", "u2"}})
+	if len(targets) != 2 || targets[0].Platform != "ios" || len(targets[0].Tokens) != 2 || targets[1].Platform != "xiaomi" {
+		t.Errorf("Resolve failed: unexpected targets %+v", targets)
+	}
+	if targets := r.Resolve(Query{UserIDs: []string{"u1"}, Platform: "xiaomi"}); len(targets) != 1 || targets[0].Tokens[0] != "b" {
+		t.Errorf("Resolve platform failed: unexpected targets %+v", targets)
+	}
+
+	// Test token moves to the new user
+	if _, err := r.Register(Device{UserID: "u2", Platform: "ios", AppID: "app", Token: "a"}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if devices := r.List("u1", "", ""); len(devices) != 1 || devices[0].Token != "b" {
+		t.Errorf("Register move failed: unexpected devices of u1 %+v", devices)
+	}
+
+	// Test refresh replaces the token
+	d, err := r.Refresh("xiaomi", "app", "b", "b2")
+	if err != nil || d.Token != "b2" || d.UserID != "u1" {
+		t.Errorf("Refresh failed: unexpected device %+v, err %v", d, err)
+	}
+	if _, ok := r.Get("xiaomi", "app", "b"); ok {
+		t.Errorf("Refresh failed: old token still registered")
+	}
+
+	// Test invalid tokens reported after registration are skipped
+	invalid.Add(feedback.InvalidToken{Platform: "ios", AppID: "app", Token: "c", Reason: feedback.ReasonUnregistered, CreatedAt: time.Now().Add(time.Second)})
+	if targets := r.Resolve(Query{UserIDs: []string{"u2"}}); len(targets) != 1 || len(targets[0].Tokens) != 1 || targets[0].Tokens[0] != "a" {
+		t.Errorf("Resolve invalid failed: unexpected targets %+v", targets)
+	}
+
+	// Test tags are kept on re-register and resolved by segment
+	if _, err := r.Tag("xiaomi", "app", "b2", []string{"vip"}, map[string]string{"plan": "pro"}); err != nil {
+		t.Fatalf("Tag failed: %v", err)
+	}
+	if _, err := r.Register(Device{UserID: "u1", Platform: "xiaomi", AppID: "app", Token: "b2"}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	segment, err := ParseSegment("tag == vip AND plan == pro")
+	if err != nil {
+		t.Fatalf("ParseSegment failed: %v", err)
+	}
+	if targets := r.Resolve(Query{Segment: segment}); len(targets) != 1 || targets[0].Tokens[0] != "b2" {
+		t.Errorf("Resolve segment failed: unexpected targets %+v", targets)
+	}
+	if targets := r.Resolve(Query{UserIDs: []string{"u2"}, Segment: segment}); len(targets) != 0 {
+		t.Errorf("Resolve segment failed: unexpected targets of u2 %+v", targets)
+	}
+
+	// Test unregister
+	if err := r.Unregister("ios", "app", "a"); err != nil {
+		t.Errorf("Unregister failed: %v", err)
+	}
+	if err := r.Unregister("ios", "app", "a"); err != ErrDeviceNotFound {
+		t.Errorf("Unregister failed: expected ErrDeviceNotFound but got %v", err)
+	}
+	if devices := r.List("u2", "", ""); len(devices) != 1 {
+		t.Errorf("Unregister failed: unexpected devices of u2 %+v", devices)
+	}
+}
@@ -0,0 +1,387 @@
+package device
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidSegment 设备分群表达式格式错误
+var ErrInvalidSegment = errors.New("invalid segment")
+
+// Segment 设备分群表达式，由条件及 AND、OR、NOT（或 &&、||、!）和括号组成，例如
+//
+//	platform == android AND app_version < 3.2 AND locale == zh-CN AND NOT tag == vip
+//
+// 条件的字段为 user_id、platform、app_id、locale、timezone、app_version 时比较设备对应的信息，
+// 为 tag 时判断设备是否有该标签（只支持 == 和 !=），其他字段比较设备的同名属性。
+// 运算符支持 ==、!=、<、<=、>、>=，两边都是版本号（例如 3.2.1）时按版本号比较，否则按字符串比较，
+// 设备没有该信息或属性时 <、<=、>、>= 不匹配。值包含空格或运算符时使用双引号
+type Segment struct {
+	expr string
+	root segmentNode
+}
+
+// ParseSegment 解析设备分群表达式
+func ParseSegment(expr string) (*Segment, error) {
+	tokens, err := lexSegment(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", ErrInvalidSegment)
+	}
+
+	p := &segmentParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidSegment, p.tokens[p.pos].text)
+	}
+	return &Segment{expr: expr, root: root}, nil
+}
+
+// Match 设备是否匹配分群表达式，nil 匹配所有设备
+func (s *Segment) Match(d Device) bool {
+	if s == nil {
+		return true
+	}
+	return s.root.match(&d)
+}
+
+func (s *Segment) String() string {
+	if s == nil {
+		return ""
+	}
+	return s.expr
+}
+
+type segmentNode interface {
+	match(d *Device) bool
+}
+
+type andNode struct {
+	left, right segmentNode
+}
+
+func (n *andNode) match(d *Device) bool {
+	return n.left.match(d) && n.right.match(d)
+}
+
+type orNode struct {
+	left, right segmentNode
+}
+
+func (n *orNode) match(d *Device) bool {
+	return n.left.match(d) || n.right.match(d)
+}
+
+type notNode struct {
+	node segmentNode
+}
+
+func (n *notNode) match(d *Device) bool {
+	return !n.node.match(d)
+}
+
+type condNode struct {
+	field string
+	op    string
+	value string
+}
+
+func (n *condNode) match(d *Device) bool {
+	if n.field == "tag" {
+		has := false
+		for _, tag := range d.Tags {
+			if strings.EqualFold(tag, n.value) {
+				has = true
+				break
+			}
+		}
+		return has == (n.op == "==")
+	}
+
+	v := d.field(n.field)
+	switch n.op {
+	case "==":
+		return equalValues(v, n.value)
+	case "!=":
+		return !equalValues(v, n.value)
+	}
+	if v == "" {
+		return false
+	}
+	c := compareValues(v, n.value)
+	switch n.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
+
+// field 返回设备信息或属性的值
+func (d *Device) field(name string) string {
+	switch strings.ToLower(name) {
+	case "user_id":
+		return d.UserID
+	case "platform":
+		return d.Platform
+	case "app_id":
+		return d.AppID
+	case "locale":
+		return d.Locale
+	case "timezone":
+		return d.Timezone
+	case "app_version":
+		return d.AppVersion
+	}
+	return d.Attributes[name]
+}
+
+func equalValues(a, b string) bool {
+	if av, ok := parseVersion(a); ok {
+		if bv, ok := parseVersion(b); ok {
+			return compareVersion(av, bv) == 0
+		}
+	}
+	return strings.EqualFold(a, b)
+}
+
+func compareValues(a, b string) int {
+	if av, ok := parseVersion(a); ok {
+		if bv, ok := parseVersion(b); ok {
+			return compareVersion(av, bv)
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// parseVersion 解析以 . 分隔的数字版本号，例如 3.10.2
+func parseVersion(s string) ([]int, bool) {
+	if s == "" {
+		return nil, false
+	}
+	parts := strings.Split(s, ".")
+	version := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		version[i] = n
+	}
+	return version, true
+}
+
+// compareVersion 比较版本号，缺少的部分视为 0
+func compareVersion(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+type segmentTokenKind int
+
+const (
+	tokenWord segmentTokenKind = iota
+	tokenString
+	tokenOp
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+)
+
+type segmentToken struct {
+	kind segmentTokenKind
+	text string
+}
+
+func lexSegment(expr string) ([]segmentToken, error) {
+	var tokens []segmentToken
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, segmentToken{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, segmentToken{kind: tokenRParen, text: ")"})
+			i++
+		case strings.HasPrefix(expr[i:], "&&"):
+			tokens = append(tokens, segmentToken{kind: tokenAnd, text: "&&"})
+			i += 2
+		case strings.HasPrefix(expr[i:], "||"):
+			tokens = append(tokens, segmentToken{kind: tokenOr, text: "||"})
+			i += 2
+		case c == '=' || c == '!' || c == '<' || c == '>':
+			op := string(c)
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				op += "="
+			}
+			i += len(op)
+			switch op {
+			case "!":
+				tokens = append(tokens, segmentToken{kind: tokenNot, text: op})
+				continue
+			case "=":
+				op = "=="
+			}
+			tokens = append(tokens, segmentToken{kind: tokenOp, text: op})
+		case c == '"':
+			end := strings.IndexByte(expr[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated string", ErrInvalidSegment)
+			}
+			tokens = append(tokens, segmentToken{kind: tokenString, text: expr[i+1 : i+1+end]})
+			i += end + 2
+		default:
+			start := i
+			for i < len(expr) && !strings.ContainsRune(" \t\n\r()&|=!<>\"", rune(expr[i])) {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidSegment, expr[i:i+1])
+			}
+			word := expr[start:i]
+			switch strings.ToUpper(word) {
+			case "AND":
+				tokens = append(tokens, segmentToken{kind: tokenAnd, text: word})
+			case "OR":
+				tokens = append(tokens, segmentToken{kind: tokenOr, text: word})
+			case "NOT":
+				tokens = append(tokens, segmentToken{kind: tokenNot, text: word})
+			default:
+				tokens = append(tokens, segmentToken{kind: tokenWord, text: word})
+			}
+		}
+	}
+	return tokens, nil
+}
+
+// segmentParser 按 NOT > AND > OR 的优先级解析分群表达式
+type segmentParser struct {
+	tokens []segmentToken
+	pos    int
+}
+
+func (p *segmentParser) next() (segmentToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return segmentToken{}, false
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, true
+}
+
+func (p *segmentParser) peek(kind segmentTokenKind) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == kind
+}
+
+func (p *segmentParser) parseOr() (segmentNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek(tokenOr) {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *segmentParser) parseAnd() (segmentNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek(tokenAnd) {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *segmentParser) parseUnary() (segmentNode, error) {
+	if p.peek(tokenNot) {
+		p.pos++
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{node: node}, nil
+	}
+	if p.peek(tokenLParen) {
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peek(tokenRParen) {
+			return nil, fmt.Errorf("%w: missing )", ErrInvalidSegment)
+		}
+		p.pos++
+		return node, nil
+	}
+	return p.parseCond()
+}
+
+func (p *segmentParser) parseCond() (segmentNode, error) {
+	field, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected end of expression", ErrInvalidSegment)
+	}
+	if field.kind != tokenWord {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidSegment, field.text)
+	}
+	op, ok := p.next()
+	if !ok || op.kind != tokenOp {
+		return nil, fmt.Errorf("%w: missing operator after %q", ErrInvalidSegment, field.text)
+	}
+	value, ok := p.next()
+	if !ok || (value.kind != tokenWord && value.kind != tokenString) {
+		return nil, fmt.Errorf("%w: missing value after %q", ErrInvalidSegment, field.text+" "+op.text)
+	}
+
+	name := field.text
+	if strings.EqualFold(name, "tag") {
+		name = "tag"
+	}
+	if name == "tag" && op.text != "==" && op.text != "!=" {
+		return nil, fmt.Errorf("%w: tag only supports == and !=", ErrInvalidSegment)
+	}
+	return &condNode{field: name, op: op.text, value: value.text}, nil
+}
@@ -0,0 +1,48 @@
+package device
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSegment(t *testing.T) {
+	android := Device{Platform: "android", AppID: "app", Locale: "zh-CN", AppVersion: "3.1.9", Tags: []string{"vip"}, Attributes: map[string]string{"plan": "pro"}}
+	ios := Device{Platform: "ios", AppID: "app", Locale: "en-US", AppVersion: "3.10"}
+
+	tests := []struct {
+		expr    string
+		android bool
+		ios     bool
+	}{
+		{"platform == android", true, false},
+		{"platform == android AND app_version < 3.2 AND locale == zh-CN", true, false},
+		{"app_version >= 3.2", false, true},
+		{"tag == vip", true, false},
+		{"NOT tag == vip", false, true},
+		{"!(tag == vip) || plan == pro", true, true},
+		{"plan != pro and locale = \"en-US\"", false, true},
+		{"platform == ios OR platform == android AND tag == vip", true, true},
+		{"(platform == ios OR platform == android) AND tag == vip", true, false},
+		{"plan < z", true, false},
+	}
+	for _, tt := range tests {
+		s, err := ParseSegment(tt.expr)
+		if err != nil {
+			t.Errorf("ParseSegment(%q) failed: %v", tt.expr, err)
+			continue
+		}
+		if got := s.Match(android); got != tt.android {
+			t.Errorf("Match(%q) android: expected %v but got %v", tt.expr, tt.android, got)
+		}
+		if got := s.Match(ios); got != tt.ios {
+			t.Errorf("Match(%q) ios: expected %v but got %v", tt.expr, tt.ios, got)
+		}
+	}
+
+	// Test invalid expressions
+	for _, expr := range []string{"", "platform", "platform ==", "(platform == ios", "tag < vip", "platform == ios AND", "locale == \"zh"} {
+		if _, err := ParseSegment(expr); !errors.Is(err, ErrInvalidSegment) {
+			t.Errorf("ParseSegment(%q): expected ErrInvalidSegment but got %v", expr, err)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package experiment
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/store"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PushExperiments A/B 测试每个变体的推送及点击统计
+var PushExperiments *Store
+
+var (
+	ErrExperimentNotFound = errors.New("experiment not found")
+	ErrVariantNotFound    = errors.New("variant not found")
+)
+
+// maxTaskIDs 每个变体保留的最近的任务id数量
+const maxTaskIDs = 100
+
+func InitPushExperiments(cfg *config.Config) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "experiments")
+	if err != nil {
+		return err
+	}
+
+	PushExperiments = NewStore(s)
+	return PushExperiments.Init()
+}
+
+// Assign 按实验标识及 token 的哈希值将设备稳定地分配到一个变体，返回 weights 中的下标，
+// 分配到各变体的设备比例与权重成正比，权重之和不大于 0 时返回 -1
+func Assign(experiment, token string, weights []int) int {
+	var total uint64
+	for _, w := range weights {
+		if w > 0 {
+			total += uint64(w)
+		}
+	}
+	if total == 0 {
+		return -1
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(experiment))
+	h.Write([]byte{0})
+	h.Write([]byte(token))
+	n := h.Sum64() % total
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if n < uint64(w) {
+			return i
+		}
+		n -= uint64(w)
+	}
+	return -1
+}
+
+// Variant 变体的统计，Send 不包括超过推送频率上限的设备
+type Variant struct {
+	Name    string `json:"name"`
+	Weight  int    `json:"weight"`
+	Send    int64  `json:"send"`
+	Success int64  `json:"success"`
+	Failure int64  `json:"failure"`
+	Click   int64  `json:"click"`
+	// TaskIDs 最近推送的厂商任务id
+	TaskIDs []string `json:"task_ids,omitempty"`
+}
+
+// Experiment A/B 测试的统计，Variants 按推送请求中变体的顺序排列
+type Experiment struct {
+	ID        string    `json:"id"`
+	Variants  []Variant `json:"variants"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (e *Experiment) variant(name string) *Variant {
+	for i := range e.Variants {
+		if e.Variants[i].Name == name {
+			return &e.Variants[i]
+		}
+	}
+	return nil
+}
+
+// Result 一次推送中一个变体的推送结果
+type Result struct {
+	Send    int
+	Success int
+	Failure int
+	TaskIDs []string
+	// Tokens 推送到该变体的设备，点击按设备推送时分配的变体计入
+	Tokens []string
+}
+
+// assignmentPrefix 设备推送时分配的变体在 store 中的 key 前缀
+const assignmentPrefix = "assignment/"
+
+// assignment 设备在实验中推送时分配的变体
+type assignment struct {
+	Variant string `json:"variant"`
+}
+
+// Store 将实验的统计以实验标识为 key 保存在 store 中，设备推送时分配的变体以 assignmentPrefix 为前缀保存
+type Store struct {
+	mutex sync.Mutex
+	store store.ObjectStore
+}
+
+func NewStore(store store.ObjectStore) *Store {
+	return &Store{store: store}
+}
+
+func (s *Store) Init() error {
+	return s.store.Init()
+}
+
+func (s *Store) Close() error {
+	if s == nil {
+		return nil
+	}
+	return s.store.Close()
+}
+
+// Declare 记录实验的变体及权重，变体已存在时更新权重，保留已有的统计
+func (s *Store) Declare(id string, variants []Variant) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	e, ok := s.get(id)
+	if !ok {
+		e = &Experiment{ID: id, CreatedAt: now}
+	}
+	for _, variant := range variants {
+		if v := e.variant(variant.Name); v != nil {
+			v.Weight = variant.Weight
+			continue
+		}
+		e.Variants = append(e.Variants, Variant{Name: variant.Name, Weight: variant.Weight})
+	}
+	e.UpdatedAt = now
+	return s.save(e)
+}
+
+// Record 累加变体一次推送的结果并记录设备分配到的变体，变体不存在时新增
+func (s *Store) Record(id, name string, r Result) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	e, ok := s.get(id)
+	if !ok {
+		e = &Experiment{ID: id, CreatedAt: now}
+	}
+	v := e.variant(name)
+	if v == nil {
+		e.Variants = append(e.Variants, Variant{Name: name})
+		v = &e.Variants[len(e.Variants)-1]
+	}
+	v.Send += int64(r.Send)
+	v.Success += int64(r.Success)
+	v.Failure += int64(r.Failure)
+	v.TaskIDs = append(v.TaskIDs, r.TaskIDs...)
+	if len(v.TaskIDs) > maxTaskIDs {
+		v.TaskIDs = v.TaskIDs[len(v.TaskIDs)-maxTaskIDs:]
+	}
+	e.UpdatedAt = now
+	if err := s.save(e); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(assignment{Variant: name})
+	if err != nil {
+		return err
+	}
+	for _, token := range r.Tokens {
+		if err := s.store.Set(assignmentKey(id, token), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Click 累加变体的点击数量，name 为空时计入设备推送时分配到的变体，变体权重修改后也不会计入其他变体
+func (s *Store) Click(id, name, token string) (string, error) {
+	if s == nil {
+		return "", ErrExperimentNotFound
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	e, ok := s.get(id)
+	if !ok {
+		return "", ErrExperimentNotFound
+	}
+	if name == "" && token != "" {
+		if data, ok := s.store.Get(assignmentKey(id, token)); ok {
+			var a assignment
+			if err := json.Unmarshal(data, &a); err == nil {
+				name = a.Variant
+			}
+		}
+	}
+	v := e.variant(name)
+	if v == nil {
+		return "", ErrVariantNotFound
+	}
+	v.Click++
+	e.UpdatedAt = time.Now()
+	return name, s.save(e)
+}
+
+// Get 查询实验的统计
+func (s *Store) Get(id string) (*Experiment, error) {
+	if s == nil {
+		return nil, ErrExperimentNotFound
+	}
+	e, ok := s.get(id)
+	if !ok {
+		return nil, ErrExperimentNotFound
+	}
+	return e, nil
+}
+
+// List 查询所有实验的统计，按最近更新时间倒序排列
+func (s *Store) List() []*Experiment {
+	if s == nil {
+		return nil
+	}
+
+	var experiments []*Experiment
+	s.store.Range("", func(key string, value []byte) bool {
+		if strings.HasPrefix(key, assignmentPrefix) {
+			return true
+		}
+		e := &Experiment{}
+		if err := json.Unmarshal(value, e); err == nil {
+			experiments = append(experiments, e)
+		}
+		return true
+	})
+	sort.Slice(experiments, func(i, j int) bool {
+		return experiments[i].UpdatedAt.After(experiments[j].UpdatedAt)
+	})
+	return experiments
+}
+
+func (s *Store) get(id string) (*Experiment, bool) {
+	data, ok := s.store.Get(id)
+	if !ok {
+		return nil, false
+	}
+	e := &Experiment{}
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, false
+	}
+	return e, true
+}
+
+func assignmentKey(id, token string) string {
+	return assignmentPrefix + id + "/" + token
+}
+
+func (s *Store) save(e *Experiment) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return s.store.Set(e.ID, data)
+}
@@ -0,0 +1,81 @@
+package experiment
+
+import (
+	"fmt"
+	"github.com/cossim/hipush/pkg/store"
+	"testing"
+)
+
+// TestAssign 测试同一设备的分配结果稳定，且分配比例与权重大致成正比
+func TestAssign(t *testing.T) {
+	weights := []int{1, 3}
+	counts := make([]int, len(weights))
+	for i := 0; i < 10000; i++ {
+		token := fmt.Sprintf("token-%d", i)
+		v := Assign("exp", token, weights)
+		if v < 0 || v >= len(weights) {
+			t.Fatalf("Assign(%s) = %d, want in [0, %d)", token, v, len(weights))
+		}
+		if again := Assign("exp", token, weights); again != v {
+			t.Fatalf("Assign(%s) = %d then %d, want stable", token, v, again)
+		}
+		counts[v]++
+	}
+	if counts[0] < 2000 || counts[0] > 3000 {
+		t.Errorf("variant 0 got %d of 10000 tokens, want about 2500", counts[0])
+	}
+	if v := Assign("exp", "token", []int{0, 0}); v != -1 {
+		t.Errorf("Assign() with zero weights = %d, want -1", v)
+	}
+}
+
+// TestStore 测试变体统计的累加以及按 token 上报点击
+func TestStore(t *testing.T) {
+	s := NewStore(store.NewMemoryObjectStore())
+	if err := s.Init(); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer s.Close()
+
+	if err := s.Declare("exp", []Variant{{Name: "a", Weight: 1}, {Name: "b", Weight: 1}}); err != nil {
+		t.Fatalf("Declare() error = %v", err)
+	}
+	s.Record("exp", "a", Result{Send: 3, Success: 2, Failure: 1, TaskIDs: []string{"t1"}, Tokens: []string{"token", "t2", "t3"}})
+	s.Record("exp", "a", Result{Send: 1, Success: 1, Tokens: []string{"t4"}})
+
+	// Test a click is credited to the variant assigned when pushing even after the weights change
+	if err := s.Declare("exp", []Variant{{Name: "a", Weight: 0}, {Name: "b", Weight: 1}}); err != nil {
+		t.Fatalf("Declare() error = %v", err)
+	}
+	name, err := s.Click("exp", "", "token")
+	if err != nil || name != "a" {
+		t.Fatalf("Click() = %s, %v, want a, nil", name, err)
+	}
+	if _, err := s.Click("exp", "", "unknown"); err != ErrVariantNotFound {
+		t.Errorf("Click() token not pushed error = %v, want %v", err, ErrVariantNotFound)
+	}
+	if _, err := s.Click("exp", "c", ""); err != ErrVariantNotFound {
+		t.Errorf("Click() unknown variant error = %v, want %v", err, ErrVariantNotFound)
+	}
+	if _, err := s.Click("missing", "a", ""); err != ErrExperimentNotFound {
+		t.Errorf("Click() unknown experiment error = %v, want %v", err, ErrExperimentNotFound)
+	}
+
+	e, err := s.Get("exp")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(e.Variants) != 2 || e.Variants[0].Name != "a" || e.Variants[1].Name != "b" {
+		t.Fatalf("Get() variants = %+v, want a, b", e.Variants)
+	}
+	a := e.Variants[0]
+	if a.Send != 4 || a.Success != 3 || a.Failure != 1 || len(a.TaskIDs) != 1 {
+		t.Errorf("variant a = %+v, want send 4, success 3, failure 1, 1 task id", a)
+	}
+	if e.Variants[0].Click != 1 || e.Variants[1].Click != 0 {
+		t.Errorf("clicks = %d, %d, want 1, 0", e.Variants[0].Click, e.Variants[1].Click)
+	}
+	if experiments := s.List(); len(experiments) != 1 || experiments[0].ID != "exp" {
+		t.Errorf("List() = %+v, want only exp", experiments)
+	}
+}
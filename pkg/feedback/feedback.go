@@ -0,0 +1,160 @@
+package feedback
+
+import (
+	"encoding/json"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/store"
+	"log"
+	"time"
+)
+
+// InvalidTokenStorage 记录厂商反馈的失效 token
+var InvalidTokenStorage *Storage
+
+func InitInvalidTokenStorage(cfg *config.Config) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "invalid_tokens")
+	if err != nil {
+		return err
+	}
+
+	InvalidTokenStorage = NewStorage(s, NewWebhook(cfg.Feedback))
+	return InvalidTokenStorage.Init()
+}
+
+// 失效原因
+const (
+	ReasonUnregistered = "unregistered" // 设备已卸载应用或注销推送
+	ReasonExpired      = "expired"      // token 已过期
+	ReasonInvalid      = "invalid"      // token 格式错误或不属于该应用
+)
+
+// InvalidToken 失效的设备 token
+type InvalidToken struct {
+	Platform  string    `json:"platform"`
+	AppID     string    `json:"app_id"`
+	Token     string    `json:"token"`
+	Reason    string    `json:"reason"`
+	Code      string    `json:"code,omitempty"`
+	Msg       string    `json:"msg,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Storage struct {
+	store   store.ObjectStore
+	webhook *Webhook
+}
+
+func NewStorage(store store.ObjectStore, webhook *Webhook) *Storage {
+	return &Storage{store: store, webhook: webhook}
+}
+
+func (s *Storage) Init() error {
+	if err := s.store.Init(); err != nil {
+		return err
+	}
+	s.webhook.Start()
+	return nil
+}
+
+func (s *Storage) Close() error {
+	s.webhook.Stop()
+	return s.store.Close()
+}
+
+// Add 记录失效 token，并在配置了 webhook 时通知业务方
+func (s *Storage) Add(tokens ...InvalidToken) {
+	if s == nil {
+		return
+	}
+	for _, t := range tokens {
+		if t.CreatedAt.IsZero() {
+			t.CreatedAt = time.Now()
+		}
+		data, err := json.Marshal(t)
+		if err != nil {
+			log.Printf("marshal invalid token error: %v", err)
+			continue
+		}
+		if err := s.store.Set(key(t.Platform, t.AppID, t.Token), data); err != nil {
+			log.Printf("save invalid token error: %v", err)
+			continue
+		}
+		s.webhook.Notify(t)
+	}
+}
+
+// AddResults 记录推送结果中厂商反馈已失效的 token
+func (s *Storage) AddResults(platform, appID string, results []push.TokenResult) {
+	var tokens []InvalidToken
+	for _, r := range results {
+		if r.InvalidReason == "" {
+			continue
+		}
+		tokens = append(tokens, InvalidToken{
+			Platform: platform,
+			AppID:    appID,
+			Token:    r.Token,
+			Reason:   r.InvalidReason,
+			Code:     r.Code,
+			Msg:      r.Msg,
+		})
+	}
+	s.Add(tokens...)
+}
+
+// Get 查询 token 是否已被记录为失效
+func (s *Storage) Get(platform, appID, token string) (InvalidToken, bool) {
+	var t InvalidToken
+	if s == nil {
+		return t, false
+	}
+	data, ok := s.store.Get(key(platform, appID, token))
+	if !ok {
+		return t, false
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return t, false
+	}
+	return t, true
+}
+
+// List 查询失效 token，platform 和 appID 为空时不进行过滤
+func (s *Storage) List(platform, appID string) []InvalidToken {
+	if s == nil {
+		return nil
+	}
+	prefix := ""
+	if platform != "" {
+		prefix = platform + "/"
+		if appID != "" {
+			prefix += appID + "/"
+		}
+	}
+
+	var tokens []InvalidToken
+	s.store.Range(prefix, func(_ string, value []byte) bool {
+		var t InvalidToken
+		if err := json.Unmarshal(value, &t); err != nil {
+			return true
+		}
+		if appID != "" && t.AppID != appID {
+			return true
+		}
+		tokens = append(tokens, t)
+		return true
+	})
+	return tokens
+}
+
+// Del 删除失效 token，业务方清理设备后可以调用
+func (s *Storage) Del(platform, appID, token string) error {
+	if s == nil {
+		return nil
+	}
+	return s.store.Del(key(platform, appID, token))
+}
+
+func key(platform, appID, token string) string {
+	return platform + "/" + appID + "/" + token
+}
@@ -0,0 +1,67 @@
+package feedback
+
+import (
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/store"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// TestStorage 测试只记录厂商反馈失效的 token，以及按平台和应用查询
+func TestStorage(t *testing.T) {
+	s := NewStorage(store.NewMemoryObjectStore(), nil)
+	if err := s.Init(); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer s.Close()
+
+	s.AddResults("ios", "app", []push.TokenResult{
+		{Token: "a", Success: true},
+		{Token: "b", Code: "410", Msg: "Unregistered", InvalidReason: ReasonUnregistered},
+		{Token: "c", Msg: "timeout"},
+	})
+	s.AddResults("ios", "app2", []push.TokenResult{{Token: "d", InvalidReason: ReasonInvalid}})
+	s.AddResults("android", "app", []push.TokenResult{{Token: "e", InvalidReason: ReasonExpired}})
+
+	token, ok := s.Get("ios", "app", "b")
+	if !ok || token.Reason != ReasonUnregistered || token.Code != "410" || token.CreatedAt.IsZero() {
+		t.Errorf("Get() = %+v, %v", token, ok)
+	}
+	for _, tok := range []string{"a", "c"} {
+		if _, ok := s.Get("ios", "app", tok); ok {
+			t.Errorf("Get(%q) recorded a token without invalid reason", tok)
+		}
+	}
+
+	tests := []struct {
+		platform string
+		appID    string
+		want     []string
+	}{
+		{"", "", []string{"b", "d", "e"}},
+		{"ios", "", []string{"b", "d"}},
+		{"ios", "app", []string{"b"}},
+		// 应用 id 为 app 的前缀时不匹配
+		{"ios", "ap", nil},
+		{"android", "app", []string{"e"}},
+		{"huawei", "", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, token := range s.List(tt.platform, tt.appID) {
+			got = append(got, token.Token)
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("List(%q, %q) = %v, want %v", tt.platform, tt.appID, got, tt.want)
+		}
+	}
+
+	if err := s.Del("ios", "app", "b"); err != nil {
+		t.Fatalf("Del() error = %v", err)
+	}
+	if _, ok := s.Get("ios", "app", "b"); ok {
+		t.Errorf("Get() after Del() found the token")
+	}
+}
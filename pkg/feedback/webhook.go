@@ -0,0 +1,135 @@
+package feedback
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cossim/hipush/config"
+	"log"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultWebhookTimeout = 5 * time.Second
+	webhookBatchSize      = 100             // 单次回调最多携带的 token 数量
+	webhookFlushInterval  = 1 * time.Second // 未达到 webhookBatchSize 时的回调间隔
+	webhookQueueSize      = 10000
+)
+
+// Webhook 将失效 token 批量回调给业务方，未配置 url 时不做任何处理
+type Webhook struct {
+	url    string
+	client *http.Client
+	queue  chan InvalidToken
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// WebhookPayload 回调请求体
+type WebhookPayload struct {
+	Tokens []InvalidToken `json:"tokens"`
+}
+
+func NewWebhook(cfg config.FeedbackConfig) *Webhook {
+	timeout := defaultWebhookTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	return &Webhook{
+		url:    cfg.Webhook,
+		client: &http.Client{Timeout: timeout},
+		queue:  make(chan InvalidToken, webhookQueueSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func (w *Webhook) Start() {
+	if w == nil || w.url == "" {
+		return
+	}
+	go w.run()
+}
+
+func (w *Webhook) Stop() {
+	if w == nil || w.url == "" {
+		return
+	}
+	close(w.stop)
+	<-w.done
+}
+
+// Notify 将失效 token 放入回调队列，队列已满时丢弃
+func (w *Webhook) Notify(t InvalidToken) {
+	if w == nil || w.url == "" {
+		return
+	}
+	select {
+	case w.queue <- t:
+	default:
+		log.Printf("invalid token webhook queue is full, drop token: %s", t.Token)
+	}
+}
+
+func (w *Webhook) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(webhookFlushInterval)
+	defer ticker.Stop()
+
+	var batch []InvalidToken
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := w.post(batch); err != nil {
+			log.Printf("invalid token webhook error: %v", err)
+		}
+		batch = nil
+	}
+
+	for {
+		select {
+		case t := <-w.queue:
+			batch = append(batch, t)
+			if len(batch) >= webhookBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-w.stop:
+			// 退出前将队列中剩余的 token 回调给业务方
+			for {
+				select {
+				case t := <-w.queue:
+					batch = append(batch, t)
+					if len(batch) >= webhookBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (w *Webhook) post(tokens []InvalidToken) error {
+	data, err := json.Marshal(WebhookPayload{Tokens: tokens})
+	if err != nil {
+		return err
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package feedback
+
+import (
+	"encoding/json"
+	"github.com/cossim/hipush/config"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestWebhook 测试达到批量上限时立即回调，未达到时按间隔回调，关闭时回调剩余的 token
+func TestWebhook(t *testing.T) {
+	var mutex sync.Mutex
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mutex.Lock()
+		batches = append(batches, len(payload.Tokens))
+		mutex.Unlock()
+	}))
+	defer server.Close()
+	posted := func() []int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]int(nil), batches...)
+	}
+	wait := func(n int, timeout time.Duration) []int {
+		deadline := time.Now().Add(timeout)
+		for len(posted()) < n && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		return posted()
+	}
+
+	w := NewWebhook(config.FeedbackConfig{Webhook: server.URL})
+	w.Start()
+
+	// Test flush on batch size
+	for i := 0; i < webhookBatchSize+1; i++ {
+		w.Notify(InvalidToken{Token: "token"})
+	}
+	if got := wait(1, webhookFlushInterval/2); len(got) != 1 || got[0] != webhookBatchSize {
+		t.Fatalf("batches after batch size = %v, want [%d]", got, webhookBatchSize)
+	}
+
+	// Test flush on interval
+	if got := wait(2, 2*webhookFlushInterval); len(got) != 2 || got[1] != 1 {
+		t.Fatalf("batches after interval = %v, want [%d 1]", got, webhookBatchSize)
+	}
+
+	// Test flush on close
+	for i := 0; i < 3; i++ {
+		w.Notify(InvalidToken{Token: "token"})
+	}
+	w.Stop()
+	if got := posted(); len(got) != 3 || got[2] != 3 {
+		t.Errorf("batches after close = %v, want [%d 1 3]", got, webhookBatchSize)
+	}
+}
@@ -0,0 +1,321 @@
+package frequency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/store"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PushCapper 推送频率控制，未初始化时不做任何限制
+var PushCapper *Capper
+
+// 超过上限时的处理方式
+const (
+	ActionDrop  = "drop"
+	ActionDefer = "defer"
+)
+
+const cleanupInterval = time.Hour
+
+func InitPushCapper(cfg *config.Config) error {
+	if len(cfg.FrequencyCaps) == 0 {
+		return nil
+	}
+
+	rules := make([]Rule, 0, len(cfg.FrequencyCaps))
+	for i, c := range cfg.FrequencyCaps {
+		r, err := NewRule(c)
+		if err != nil {
+			return fmt.Errorf("frequency_caps[%d]: %w", i, err)
+		}
+		rules = append(rules, r)
+	}
+
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "frequency_caps")
+	if err != nil {
+		return err
+	}
+	PushCapper = NewCapper(s, rules)
+	return PushCapper.Start()
+}
+
+// Rule 频率控制规则，同一设备以及同一用户在 Window 内最多收到 Limit 条匹配规则的推送，
+// Platform、AppID、Category 为空时匹配所有平台、应用或消息类别
+type Rule struct {
+	Platform string
+	AppID    string
+	Category string
+	Limit    int
+	Window   time.Duration
+	Action   string
+}
+
+func NewRule(c config.FrequencyCapConfig) (Rule, error) {
+	r := Rule{
+		Platform: c.Platform,
+		AppID:    c.AppID,
+		Category: c.Category,
+		Limit:    c.Limit,
+		Window:   time.Duration(c.Window) * time.Second,
+		Action:   strings.ToLower(c.Action),
+	}
+	if r.Limit <= 0 || r.Window <= 0 {
+		return r, fmt.Errorf("limit and window must be greater than 0")
+	}
+	switch r.Action {
+	case "":
+		r.Action = ActionDrop
+	case ActionDrop, ActionDefer:
+	default:
+		return r, fmt.Errorf("invalid action %q", c.Action)
+	}
+	return r, nil
+}
+
+func (r Rule) match(platform, appID, category string) bool {
+	return (r.Platform == "" || r.Platform == platform) &&
+		(r.AppID == "" || r.AppID == appID) &&
+		(r.Category == "" || strings.EqualFold(r.Category, category))
+}
+
+// key 规则的标识，作为保存推送记录的 key 前缀
+func (r Rule) key() string {
+	parts := []string{r.Platform, r.AppID, strings.ToLower(r.Category)}
+	for i, p := range parts {
+		if p == "" {
+			parts[i] = "*"
+		}
+	}
+	return strings.Join(parts, "/") + "/" + r.Window.String()
+}
+
+func (r Rule) String() string {
+	category := r.Category
+	if category == "" {
+		category = "all"
+	}
+	return fmt.Sprintf("%d %s notifications per %s", r.Limit, category, r.Window)
+}
+
+// Admitted 同一次推送中已记录推送数量的用户，key 为规则及用户的标识，只在 Admit 持有锁时读写
+type Admitted map[string]bool
+
+type admittedKey struct{}
+
+// WithAdmitted 返回记录已计入推送数量的用户的 ctx，ctx 中已有时直接返回 ctx。
+// 同一次推送拆分为多个平台或应用的请求推送时共用，用户在多个平台的设备只记录一次
+func WithAdmitted(ctx context.Context) context.Context {
+	if AdmittedFrom(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, admittedKey{}, Admitted{})
+}
+
+// AdmittedFrom 返回 ctx 中记录的已计入推送数量的用户，没有时返回 nil
+func AdmittedFrom(ctx context.Context) Admitted {
+	admitted, _ := ctx.Value(admittedKey{}).(Admitted)
+	return admitted
+}
+
+// Capped 超过频率上限的设备，Action 为 defer 时 RetryAt 为设备重新有余量的时间
+type Capped struct {
+	Token   string
+	Rule    Rule
+	Action  string
+	RetryAt time.Time
+}
+
+// Capper 按规则统计每个设备及用户在滑动窗口内收到的推送数量，推送记录保存在 store 中
+type Capper struct {
+	store store.ObjectStore
+	rules []Rule
+
+	mutex sync.Mutex
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// record 设备或用户在规则的窗口内的推送时间（unix 时间戳，以毫秒为单位）
+type record struct {
+	Times   []int64 `json:"times"`
+	Expires int64   `json:"expires"`
+}
+
+func NewCapper(store store.ObjectStore, rules []Rule) *Capper {
+	return &Capper{
+		store: store,
+		rules: rules,
+		stop:  make(chan struct{}),
+	}
+}
+
+func (c *Capper) Start() error {
+	if err := c.store.Init(); err != nil {
+		return err
+	}
+	c.wg.Add(1)
+	go c.cleanup()
+	return nil
+}
+
+func (c *Capper) Close() error {
+	if c == nil {
+		return nil
+	}
+	close(c.stop)
+	c.wg.Wait()
+	return c.store.Close()
+}
+
+// Admit 在推送前按匹配的规则检查每个设备，返回未超过上限的设备并记录本次推送，超过上限的设备不记录。
+// userOf 返回设备所属的用户，不为空时同时检查用户的推送数量，同一次推送中用户的多个设备只记录一次，
+// admitted 不为空时跨多次调用记录已计入的用户，为空时只在本次调用中去重。
+// 设备同时超过多个规则的上限时，有任一规则为 drop 则丢弃，否则推迟到所有规则都有余量的时间
+func (c *Capper) Admit(platform, appID, category string, tokens []string, userOf func(token string) string, admitted Admitted, now time.Time) ([]string, []Capped) {
+	if c == nil {
+		return tokens, nil
+	}
+	var rules []Rule
+	for _, r := range c.rules {
+		if r.match(platform, appID, category) {
+			rules = append(rules, r)
+		}
+	}
+	if len(rules) == 0 {
+		return tokens, nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if admitted == nil {
+		admitted = Admitted{}
+	}
+	var allowed []string
+	var capped []Capped
+	for _, token := range tokens {
+		var userID string
+		if userOf != nil {
+			userID = userOf(token)
+		}
+
+		var over *Capped
+		for _, r := range rules {
+			retryAt, ok := c.check(r, tokenKey(r, platform, appID, token), now)
+			if ok && userID != "" && !admitted[userKey(r, userID)] {
+				retryAt, ok = c.check(r, userKey(r, userID), now)
+			}
+			if ok {
+				continue
+			}
+			if over == nil {
+				over = &Capped{Token: token, Rule: r, Action: r.Action, RetryAt: retryAt}
+				continue
+			}
+			if r.Action == ActionDrop {
+				over.Rule, over.Action = r, ActionDrop
+			}
+			if retryAt.After(over.RetryAt) {
+				over.RetryAt = retryAt
+			}
+		}
+		if over != nil {
+			capped = append(capped, *over)
+			continue
+		}
+
+		for _, r := range rules {
+			c.add(r, tokenKey(r, platform, appID, token), now)
+			if key := userKey(r, userID); userID != "" && !admitted[key] {
+				c.add(r, key, now)
+				admitted[key] = true
+			}
+		}
+		allowed = append(allowed, token)
+	}
+	return allowed, capped
+}
+
+// check 返回 key 在窗口内的推送数量是否未达到上限，已达到上限时同时返回最早的推送移出窗口的时间
+func (c *Capper) check(r Rule, key string, now time.Time) (time.Time, bool) {
+	times := c.times(key, r.Window, now)
+	if len(times) < r.Limit {
+		return now, true
+	}
+	return time.UnixMilli(times[len(times)-r.Limit]).Add(r.Window), false
+}
+
+func (c *Capper) add(r Rule, key string, now time.Time) {
+	times := append(c.times(key, r.Window, now), now.UnixMilli())
+	data, err := json.Marshal(&record{Times: times, Expires: now.Add(r.Window).UnixMilli()})
+	if err != nil {
+		return
+	}
+	if err := c.store.Set(key, data); err != nil {
+		log.Printf("failed to save frequency cap record %s: %v", key, err)
+	}
+}
+
+// times 返回 key 在窗口内的推送时间，按时间排序
+func (c *Capper) times(key string, window time.Duration, now time.Time) []int64 {
+	data, ok := c.store.Get(key)
+	if !ok {
+		return nil
+	}
+	r := &record{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil
+	}
+	start := now.Add(-window).UnixMilli()
+	times := r.Times[:0]
+	for _, t := range r.Times {
+		if t > start {
+			times = append(times, t)
+		}
+	}
+	return times
+}
+
+// cleanup 定期删除窗口内已没有推送的记录
+func (c *Capper) cleanup() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.mutex.Lock()
+			now := time.Now().UnixMilli()
+			var expired []string
+			c.store.Range("", func(key string, value []byte) bool {
+				r := &record{}
+				if err := json.Unmarshal(value, r); err != nil || r.Expires < now {
+					expired = append(expired, key)
+				}
+				return true
+			})
+			for _, key := range expired {
+				c.store.Del(key)
+			}
+			c.mutex.Unlock()
+		}
+	}
+}
+
+func tokenKey(r Rule, platform, appID, token string) string {
+	return strings.Join([]string{r.key(), "token", platform, appID, token}, "/")
+}
+
+func userKey(r Rule, userID string) string {
+	return strings.Join([]string{r.key(), "user", userID}, "/")
+}
@@ -0,0 +1,99 @@
+package frequency
+
+import (
+	"context"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/store"
+	"testing"
+	"time"
+)
+
+// TestCapper 测试设备及用户在滑动窗口内的推送数量上限
+func TestCapper(t *testing.T) {
+	marketing, err := NewRule(config.FrequencyCapConfig{Category: "marketing", Limit: 2, Window: 3600})
+	if err != nil {
+		t.Fatalf("NewRule() error = %v", err)
+	}
+	daily, err := NewRule(config.FrequencyCapConfig{AppID: "app", Limit: 3, Window: 86400, Action: "defer"})
+	if err != nil {
+		t.Fatalf("NewRule() error = %v", err)
+	}
+	if _, err := NewRule(config.FrequencyCapConfig{Limit: 1, Window: 60, Action: "queue"}); err == nil {
+		t.Errorf("NewRule() with invalid action error = nil, want error")
+	}
+
+	c := NewCapper(store.NewMemoryObjectStore(), []Rule{marketing, daily})
+	if err := c.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer c.Close()
+
+	users := map[string]string{"a1": "alice", "a2": "alice"}
+	userOf := func(token string) string { return users[token] }
+	now := time.Now()
+
+	// 同一次推送中用户的多个设备只记录一次
+	for i := 0; i < 2; i++ {
+		allowed, capped := c.Admit("android", "app", "marketing", []string{"a1", "a2", "b"}, userOf, nil, now.Add(time.Duration(i)*time.Minute))
+		if len(allowed) != 3 || len(capped) != 0 {
+			t.Fatalf("Admit() #%d = %v, %v, want all allowed", i, allowed, capped)
+		}
+	}
+	allowed, capped := c.Admit("android", "app", "Marketing", []string{"a1", "b"}, userOf, nil, now.Add(2*time.Minute))
+	if len(allowed) != 0 || len(capped) != 2 || capped[0].Action != ActionDrop {
+		t.Errorf("Admit() over marketing cap = %v, %v, want both dropped", allowed, capped)
+	}
+
+	// 滑动窗口移出最早的推送后恢复，不匹配 marketing 的推送只受每日上限限制
+	allowed, _ = c.Admit("android", "app", "marketing", []string{"b"}, userOf, nil, now.Add(61*time.Minute))
+	if len(allowed) != 1 {
+		t.Errorf("Admit() after window = %v, want [b]", allowed)
+	}
+	allowed, capped = c.Admit("android", "app", "", []string{"b"}, userOf, nil, now.Add(62*time.Minute))
+	if len(allowed) != 0 || len(capped) != 1 || capped[0].Action != ActionDefer {
+		t.Fatalf("Admit() over daily cap = %v, %v, want deferred", allowed, capped)
+	}
+	if want := now.Add(24 * time.Hour); !capped[0].RetryAt.Equal(time.UnixMilli(now.UnixMilli()).Add(24 * time.Hour)) {
+		t.Errorf("RetryAt = %v, want %v", capped[0].RetryAt, want)
+	}
+
+	// 其他应用不匹配规则
+	if allowed, _ := c.Admit("android", "other", "", []string{"b"}, userOf, nil, now); len(allowed) != 1 {
+		t.Errorf("Admit() for other app = %v, want [b]", allowed)
+	}
+}
+
+// TestCapperAcrossPlatforms 测试同一次推送中用户在多个平台的设备只记录一次
+func TestCapperAcrossPlatforms(t *testing.T) {
+	once, err := NewRule(config.FrequencyCapConfig{Limit: 1, Window: 3600})
+	if err != nil {
+		t.Fatalf("NewRule() error = %v", err)
+	}
+	c := NewCapper(store.NewMemoryObjectStore(), []Rule{once})
+	if err := c.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer c.Close()
+
+	users := map[string]string{"i1": "alice", "a1": "alice"}
+	userOf := func(token string) string { return users[token] }
+	now := time.Now()
+
+	ctx := WithAdmitted(context.Background())
+	if WithAdmitted(ctx) != ctx {
+		t.Errorf("WithAdmitted() replaced the admitted users of ctx")
+	}
+	admitted := AdmittedFrom(ctx)
+	if allowed, capped := c.Admit("ios", "app", "", []string{"i1"}, userOf, admitted, now); len(allowed) != 1 || len(capped) != 0 {
+		t.Fatalf("Admit() ios = %v, %v, want [i1]", allowed, capped)
+	}
+	if allowed, capped := c.Admit("android", "app", "", []string{"a1"}, userOf, admitted, now); len(allowed) != 1 || len(capped) != 0 {
+		t.Fatalf("Admit() android in the same push = %v, %v, want [a1]", allowed, capped)
+	}
+
+	// 下一次推送中用户已达到上限
+	allowed, capped := c.Admit("android", "app", "", []string{"a1"}, userOf, AdmittedFrom(WithAdmitted(context.Background())), now)
+	if len(allowed) != 0 || len(capped) != 1 {
+		t.Errorf("Admit() next push = %v, %v, want capped", allowed, capped)
+	}
+}
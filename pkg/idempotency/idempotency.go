@@ -0,0 +1,182 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/store"
+	"log"
+	"sync"
+	"time"
+)
+
+// PushKeys 推送请求的幂等 key，窗口期内相同 key 的推送请求直接返回第一次推送的结果
+var PushKeys *Store
+
+const (
+	defaultWindow   = 24 * time.Hour
+	cleanupInterval = time.Hour
+)
+
+func InitPushKeys(cfg *config.Config) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "idempotency")
+	if err != nil {
+		return err
+	}
+
+	window := defaultWindow
+	if cfg.Idempotency.Window > 0 {
+		window = time.Duration(cfg.Idempotency.Window) * time.Hour
+	}
+	PushKeys = NewStore(s, window)
+	return PushKeys.Start()
+}
+
+// Store 记录窗口期内已完成请求的结果，并合并相同 key 正在处理中的请求
+type Store struct {
+	store  store.ObjectStore
+	window time.Duration
+
+	mutex sync.Mutex
+	calls map[string]*call
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// call 正在处理中的请求，处理结束后关闭 done
+type call struct {
+	done  chan struct{}
+	value []byte
+}
+
+type record struct {
+	Value     []byte    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewStore(store store.ObjectStore, window time.Duration) *Store {
+	if window <= 0 {
+		window = defaultWindow
+	}
+	return &Store{
+		store:  store,
+		window: window,
+		calls:  make(map[string]*call),
+		stop:   make(chan struct{}),
+	}
+}
+
+func (s *Store) Start() error {
+	if err := s.store.Init(); err != nil {
+		return err
+	}
+	s.wg.Add(1)
+	go s.cleanup()
+	return nil
+}
+
+func (s *Store) Close() error {
+	if s == nil {
+		return nil
+	}
+	close(s.stop)
+	s.wg.Wait()
+	return s.store.Close()
+}
+
+// Do 处理 key 对应的请求并返回 fn 的结果，replayed 为 true 表示结果来自之前相同 key 的请求：
+// 窗口期内已保存结果时直接返回保存的结果，相同 key 的请求正在处理时等待其处理结束并返回相同的结果。
+// fn 返回的 save 为 false 时不保存结果（例如推送失败），之后相同 key 的请求会重新处理。
+// Store 为 nil 时直接调用 fn
+func (s *Store) Do(ctx context.Context, key string, fn func() (value []byte, save bool)) (value []byte, replayed bool, err error) {
+	if s == nil {
+		value, _ = fn()
+		return value, false, nil
+	}
+
+	s.mutex.Lock()
+	if v, ok := s.get(key); ok {
+		s.mutex.Unlock()
+		return v, true, nil
+	}
+	if c, ok := s.calls[key]; ok {
+		s.mutex.Unlock()
+		select {
+		case <-c.done:
+			return c.value, true, nil
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+	c := &call{done: make(chan struct{})}
+	s.calls[key] = c
+	s.mutex.Unlock()
+
+	var save bool
+	defer func() {
+		s.mutex.Lock()
+		if save {
+			if err := s.set(key, c.value); err != nil {
+				log.Printf("failed to save idempotency key %s: %v", key, err)
+			}
+		}
+		delete(s.calls, key)
+		s.mutex.Unlock()
+		close(c.done)
+	}()
+	c.value, save = fn()
+	return c.value, false, nil
+}
+
+// get 查询窗口期内保存的结果，调用方需持有锁
+func (s *Store) get(key string) ([]byte, bool) {
+	data, ok := s.store.Get(key)
+	if !ok {
+		return nil, false
+	}
+	r := &record{}
+	if err := json.Unmarshal(data, r); err != nil || time.Since(r.CreatedAt) > s.window {
+		return nil, false
+	}
+	return r.Value, true
+}
+
+func (s *Store) set(key string, value []byte) error {
+	data, err := json.Marshal(&record{Value: value, CreatedAt: time.Now()})
+	if err != nil {
+		return err
+	}
+	return s.store.Set(key, data)
+}
+
+// cleanup 定期删除超过窗口期的结果
+func (s *Store) cleanup() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			var expired []string
+			s.store.Range("", func(key string, value []byte) bool {
+				r := &record{}
+				if err := json.Unmarshal(value, r); err != nil || time.Since(r.CreatedAt) > s.window {
+					expired = append(expired, key)
+				}
+				return true
+			})
+			s.mutex.Lock()
+			for _, key := range expired {
+				// 遍历之后可能已经保存了新的结果
+				if _, ok := s.get(key); !ok {
+					s.store.Del(key)
+				}
+			}
+			s.mutex.Unlock()
+		}
+	}
+}
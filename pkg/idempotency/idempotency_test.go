@@ -0,0 +1,74 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/cossim/hipush/pkg/store"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestStore 测试保存结果的重放、处理中请求的合并及窗口期过期
+func TestStore(t *testing.T) {
+	s := NewStore(store.NewMemoryObjectStore(), time.Hour)
+	if err := s.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+
+	calls := 0
+	fn := func(value string, save bool) func() ([]byte, bool) {
+		return func() ([]byte, bool) {
+			calls++
+			return []byte(value), save
+		}
+	}
+
+	value, replayed, err := s.Do(ctx, "a", fn("first", true))
+	if err != nil || replayed || string(value) != "first" {
+		t.Fatalf("Do() = %s, %v, %v, want first, false, nil", value, replayed, err)
+	}
+	value, replayed, err = s.Do(ctx, "a", fn("second", true))
+	if err != nil || !replayed || string(value) != "first" || calls != 1 {
+		t.Errorf("Do() = %s, %v, %v, calls %d, want first, true, nil, calls 1", value, replayed, err, calls)
+	}
+
+	// 不保存的结果之后会重新处理
+	s.Do(ctx, "b", fn("failed", false))
+	value, replayed, _ = s.Do(ctx, "b", fn("retried", true))
+	if replayed || string(value) != "retried" {
+		t.Errorf("Do() after unsaved result = %s, %v, want retried, false", value, replayed)
+	}
+
+	// 处理中的相同 key 的请求等待并返回相同的结果
+	started, release := make(chan struct{}), make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.Do(ctx, "c", func() ([]byte, bool) {
+			close(started)
+			<-release
+			return []byte("in flight"), false
+		})
+	}()
+	<-started
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+	value, replayed, err = s.Do(ctx, "c", fn("duplicate", true))
+	if err != nil || !replayed || string(value) != "in flight" {
+		t.Errorf("Do() while in flight = %s, %v, %v, want in flight, true, nil", value, replayed, err)
+	}
+	wg.Wait()
+
+	// 超过窗口期的结果不再重放
+	s.window = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	value, replayed, _ = s.Do(ctx, "a", fn("expired", true))
+	if replayed || string(value) != "expired" {
+		t.Errorf("Do() after window = %s, %v, want expired, false", value, replayed)
+	}
+}
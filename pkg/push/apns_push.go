@@ -8,7 +8,10 @@ import (
 	"errors"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/logr"
 	"github.com/sideshow/apns2"
@@ -16,7 +19,9 @@ import (
 	"github.com/sideshow/apns2/token"
 	"log"
 	"net"
+	"net/http"
 	"path/filepath"
+	"strconv"
 	"time"
 )
 
@@ -36,9 +41,14 @@ const (
 
 // APNsService 实现APNs推送，实现 PushService 接口
 type APNsService struct {
+	// APNs 不支持主题订阅
+	unsupportedTopicManager
+
 	clients        map[string]*apns2.Client
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
+	feedback       *feedback.Storage
 	logger         logr.Logger
 }
 
@@ -51,7 +61,9 @@ func NewAPNsService(cfg *config.Config, logger logr.Logger) *APNsService {
 	s := &APNsService{
 		clients:        make(map[string]*apns2.Client),
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
+		feedback:       feedback.InvalidTokenStorage,
 		logger:         logger,
 	}
 
@@ -89,6 +101,7 @@ func NewAPNsService(cfg *config.Config, logger logr.Logger) *APNsService {
 				panic(err)
 			}
 			s.clients[v.AppID] = client
+			s.defaults[v.AppID] = v.SendConfig
 			if v.AppName != "" {
 				s.appNameToIDMap[v.AppName] = v.AppID
 			}
@@ -143,6 +156,12 @@ var DialTLS = func(cfg *tls.Config) func(network, addr string) (net.Conn, error)
 }
 
 func (a *APNsService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return a.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -158,6 +177,7 @@ func (a *APNsService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = a.request(appid, req)
 
 	if err := a.checkNotification(req); err != nil {
 		return nil, err
@@ -172,13 +192,21 @@ func (a *APNsService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(a.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(a.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return a.send(appid, token, notification)
 	}
 
-	resp, err := RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	resp, err := RetrySend(ctx, breakerSend(a.Name(), appid, limitSend(a.Name(), appid, send)), req.GetToken(), a.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, a.retryable), a.defaults.maxConcurrent(appid))
+	a.feedback.AddResults(a.Name(), appid, resp.Results)
 	if err != nil {
-		return nil, err
+		return &push.SendResponse{Results: resp.Results}, err
 	}
 
 	taskid, err := a.getTaskIDFromResponse(resp)
@@ -186,7 +214,94 @@ func (a *APNsService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 		return nil, err
 	}
 
-	return &push.SendResponse{TaskId: taskid}, nil
+	return &push.SendResponse{TaskId: taskid, Results: resp.Results}, nil
+}
+
+// apnsMulticastBatchSize APNs 没有批量推送接口，每个分片内仍然基于 HTTP/2 逐个 token 并发推送
+const apnsMulticastBatchSize = 1000
+
+func (a *APNsService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return a.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = a.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = a.request(appid, req)
+
+	if err := a.checkNotification(req); err != nil {
+		return nil, err
+	}
+
+	notification, err := a.buildNotification(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(a.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(a.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
+	// 重试在分片内按 token 进行，避免整个分片重试导致已成功的设备重复收到消息
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		return RetrySend(ctx, breakerSend(a.Name(), appid, limitSend(a.Name(), appid, func(ctx context.Context, token string) (*Response, error) {
+			n := *notification
+			return a.send(appid, token, &n)
+		})), tokens, a.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, a.retryable), a.defaults.maxConcurrent(appid))
+	}
+
+	resps, results, err := MulticastSend(ctx, send, req.GetToken(), mo.BatchSize(apnsMulticastBatchSize), time.Duration(mo.Delay)*time.Millisecond, nil)
+	a.feedback.AddResults(a.Name(), appid, results)
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	resp := &push.SendResponse{Results: results}
+	for _, r := range resps {
+		if taskID, err := a.getTaskIDFromResponse(r); err == nil {
+			resp.TaskIDs = append(resp.TaskIDs, taskID)
+		}
+	}
+	if len(resp.TaskIDs) > 0 {
+		resp.TaskId = resp.TaskIDs[0]
+	}
+	return resp, nil
+}
+
+// invalidReason 根据 APNs 的响应判断 token 是否已失效
+func (a *APNsService) invalidReason(res *apns2.Response) string {
+	switch res.Reason {
+	case apns2.ReasonUnregistered:
+		return feedback.ReasonUnregistered
+	case apns2.ReasonBadDeviceToken, apns2.ReasonDeviceTokenNotForTopic:
+		return feedback.ReasonInvalid
+	}
+	if res.StatusCode == http.StatusGone {
+		return feedback.ReasonUnregistered
+	}
+	return ""
 }
 
 // getTaskIDFromResponse 从 Response 结构体中获取 RequestId
@@ -224,6 +339,18 @@ type Sound struct {
 	Volume float32 `json:"volume,omitempty"`
 }
 
+// request 使用应用配置的推送默认值，APNs 的 TTL 为消息过期的时间戳，默认 TTL 按当前时间换算
+func (a *APNsService) request(appid string, req push.SendRequest) push.SendRequest {
+	c, ok := a.defaults[appid]
+	if !ok {
+		return req
+	}
+	if c.TTL > 0 {
+		c.TTL += time.Now().Unix()
+	}
+	return sendDefaults{appid: c}.request(appid, req)
+}
+
 func (a *APNsService) buildNotification(req push.SendRequest) (*apns2.Notification, error) {
 	topic := req.GetTopic()
 	if topic == "" {
@@ -388,12 +515,19 @@ func (a *APNsService) buildNotification(req push.SendRequest) (*apns2.Notificati
 
 func (a *APNsService) send(appid string, token string, notification *apns2.Notification) (*Response, error) {
 	if _, ok := a.clients[appid]; !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 	resp := &Response{Code: Fail}
 	a.status.AddIosTotal(1)
-	notification.DeviceToken = token
-	res, err := a.clients[appid].Push(notification)
+	// 并发推送时共用同一个 notification，复制后再设置设备 token
+	n := *notification
+	n.DeviceToken = token
+	res, err := a.clients[appid].Push(&n)
+	if res != nil {
+		resp.VendorCode = strconv.Itoa(res.StatusCode)
+		resp.MessageID = res.ApnsID
+		resp.InvalidReason = a.invalidReason(res)
+	}
 	if err != nil {
 		log.Printf("apns send error: %s", err)
 		a.status.AddIosFailed(1)
@@ -414,6 +548,20 @@ func (a *APNsService) send(appid string, token string, notification *apns2.Notif
 	return resp, err
 }
 
+// retryable provider token 过期时 apns2 会在下次推送时重新生成 token，可以重试，
+// 其余按 HTTP 状态码判断
+func (a *APNsService) retryable(res *Response, err error) (bool, time.Duration) {
+	if res != nil && res.InvalidReason == "" {
+		if res.Msg == apns2.ReasonExpiredProviderToken {
+			return true, 0
+		}
+		if status, _ := strconv.Atoi(res.VendorCode); status != 0 {
+			return retryableStatus(status), 0
+		}
+	}
+	return DefaultClassifier(res, err)
+}
+
 func (a *APNsService) checkNotification(req push.SendRequest) error {
 	if len(req.GetToken()) == 0 {
 		return errors.New("tokens cannot be empty")
@@ -430,6 +578,94 @@ func (a *APNsService) checkNotification(req push.SendRequest) error {
 	return nil
 }
 
+// CheckDevice APNs 没有只校验不推送的接口，使用不展示的静默推送校验 token，
+// token 不属于配置的环境时会再使用另一个环境校验，用于区分 sandbox 和 production 的 token。
+// 校验 token 有效时静默推送会真实到达设备并在后台唤醒应用，每次校验与 Send 一样计入限流、每日配额及熔断统计
+func (a *APNsService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), a.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	client, ok := a.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	other := *client
+	other.Host = apns2.HostProduction
+	if client.Host == apns2.HostProduction {
+		other.Host = apns2.HostDevelopment
+	}
+
+	validate := func(ctx context.Context, token string) push.DeviceResult {
+		res, err := a.probe(ctx, client, appid, token)
+		if err != nil {
+			return push.DeviceResult{Status: push.DeviceUnknown, Reason: err.Error()}
+		}
+		if res.StatusCode == http.StatusOK {
+			return push.DeviceResult{Status: push.DeviceValid, Environment: apnsEnvironment(client.Host)}
+		}
+		if res.Reason != apns2.ReasonBadDeviceToken {
+			if a.invalidReason(res) != "" {
+				return push.DeviceResult{Status: push.DeviceInvalid, Reason: res.Reason}
+			}
+			return push.DeviceResult{Status: push.DeviceUnknown, Reason: res.Reason}
+		}
+
+		// token 可能属于另一个环境
+		res, err = a.probe(ctx, &other, appid, token)
+		if err == nil && res.StatusCode == http.StatusOK {
+			return push.DeviceResult{Status: push.DeviceInvalid, Reason: "token belongs to " + apnsEnvironment(other.Host) + " environment", Environment: apnsEnvironment(other.Host)}
+		}
+		return push.DeviceResult{Status: push.DeviceInvalid, Reason: apns2.ReasonBadDeviceToken}
+	}
+
+	return checkDevices(ctx, a.Name(), appid, req.GetToken(), apnsTokenFormat, a.feedback, validate, co)
+}
+
+// probe 经过与 Send 相同的熔断及限流发送 content-available 的静默推送，设备不会展示通知，
+// 但静默推送会到达设备并占用一个设备的每日配额
+func (a *APNsService) probe(ctx context.Context, client *apns2.Client, appid, token string) (*apns2.Response, error) {
+	if err := ratelimit.PushLimiter.Reserve(a.Name(), appid, 1); err != nil {
+		return nil, err
+	}
+
+	var res *apns2.Response
+	send := func(ctx context.Context, token string) (*Response, error) {
+		var err error
+		res, err = client.PushWithContext(ctx, &apns2.Notification{
+			DeviceToken: token,
+			Topic:       appid,
+			PushType:    apns2.PushTypeBackground,
+			Priority:    apns2.PriorityLow,
+			Payload:     payload.NewPayload().ContentAvailable(),
+		})
+		resp := &Response{Code: Fail}
+		if res != nil {
+			resp.InvalidReason = a.invalidReason(res)
+			if res.StatusCode == http.StatusOK {
+				resp.Code = Success
+			}
+		}
+		return resp, err
+	}
+	if _, err := breakerSend(a.Name(), appid, limitSend(a.Name(), appid, send))(ctx, token); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func apnsEnvironment(host string) string {
+	if host == apns2.HostProduction {
+		return "production"
+	}
+	return "development"
+}
+
 func (a *APNsService) Name() string {
 	return consts.PlatformIOS.String()
 }
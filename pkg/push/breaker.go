@@ -0,0 +1,47 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/pkg/breaker"
+)
+
+// breakerSend 熔断器打开时快速失败，否则调用厂商接口并上报调用结果
+func breakerSend(platform, appID string, send SendFunc) SendFunc {
+	return func(ctx context.Context, token string) (*Response, error) {
+		done, err := breaker.PushBreakers.Allow(platform, appID)
+		if err != nil {
+			return nil, err
+		}
+		res, err := send(ctx, token)
+		done(endpointError(res, err))
+		return res, err
+	}
+}
+
+// breakerBatchSend 熔断器打开时快速失败，否则调用厂商批量接口并上报调用结果
+func breakerBatchSend(platform, appID string, send BatchSendFunc) BatchSendFunc {
+	return func(ctx context.Context, tokens []string) (*Response, error) {
+		done, err := breaker.PushBreakers.Allow(platform, appID)
+		if err != nil {
+			return nil, err
+		}
+		res, err := send(ctx, tokens)
+		done(endpointError(res, err))
+		return res, err
+	}
+}
+
+// endpointError 返回厂商接口本身的错误，token 失效属于设备的问题，不计为厂商接口失败
+func endpointError(res *Response, err error) error {
+	if res != nil && res.InvalidReason != "" {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if res == nil || res.Code != Success {
+		return errors.New("push failed")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package push
+
+import (
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+)
+
+// defaultMaxConcurrentPushes 应用未配置 max_concurrent_pushes 时单次推送同时调用厂商接口的最大数量
+const defaultMaxConcurrentPushes = 100
+
+// sendDefaults 各应用配置的推送默认值，key 为 appid
+type sendDefaults map[string]config.SendConfig
+
+// retryPolicy 推送选项未指定重试次数或重试间隔时使用应用配置的默认值
+func (d sendDefaults) retryPolicy(appid string, retry, retryInterval int32, classifier RetryClassifier) *RetryPolicy {
+	c := d[appid]
+	if retry == 0 {
+		retry = int32(c.MaxRetry)
+	}
+	if retryInterval == 0 {
+		retryInterval = int32(c.RetryInterval)
+	}
+	return NewRetryPolicy(retry, retryInterval, classifier)
+}
+
+// maxConcurrent 单次推送同时调用厂商接口的最大数量
+func (d sendDefaults) maxConcurrent(appid string) int {
+	if n := d[appid].MaxConcurrentPushes; n > 0 {
+		return n
+	}
+	return defaultMaxConcurrentPushes
+}
+
+// request 推送请求未指定 TTL 或优先级时使用应用配置的默认值
+func (d sendDefaults) request(appid string, req push.SendRequest) push.SendRequest {
+	c, ok := d[appid]
+	if !ok || (c.TTL == 0 && c.Priority == "") {
+		return req
+	}
+	return &defaultsRequest{SendRequest: req, ttl: c.TTL, priority: c.Priority}
+}
+
+// defaultsRequest 使用应用默认 TTL 及优先级的推送请求
+type defaultsRequest struct {
+	push.SendRequest
+	ttl      int64
+	priority string
+}
+
+func (r *defaultsRequest) GetTTL() int64 {
+	if ttl := r.SendRequest.GetTTL(); ttl != 0 {
+		return ttl
+	}
+	return r.ttl
+}
+
+func (r *defaultsRequest) GetPriority() string {
+	if priority := r.SendRequest.GetPriority(); priority != "" {
+		return priority
+	}
+	return r.priority
+}
@@ -0,0 +1,37 @@
+package push
+
+import (
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/config"
+	"testing"
+)
+
+func TestSendDefaults(t *testing.T) {
+	d := sendDefaults{"app": config.SendConfig{MaxConcurrentPushes: 10, MaxRetry: 3, RetryInterval: 2, TTL: 60, Priority: "high"}}
+
+	// Test app defaults are used when the request does not set them
+	if n := d.maxConcurrent("app"); n != 10 {
+		t.Errorf("maxConcurrent failed: expected 10 but got %d", n)
+	}
+	if n := d.maxConcurrent("other"); n != defaultMaxConcurrentPushes {
+		t.Errorf("maxConcurrent failed: expected %d but got %d", defaultMaxConcurrentPushes, n)
+	}
+	p := d.retryPolicy("app", 0, 0, nil)
+	if p.MaxRetries != 3 || p.InitialInterval.Seconds() != 2 {
+		t.Errorf("retryPolicy failed: unexpected policy %+v", p)
+	}
+	req := d.request("app", &v1.AndroidPushRequestData{})
+	if req.GetTTL() != 60 || req.GetPriority() != "high" {
+		t.Errorf("request failed: expected default ttl and priority but got %d %s", req.GetTTL(), req.GetPriority())
+	}
+
+	// Test request overrides app defaults
+	p = d.retryPolicy("app", 1, 5, nil)
+	if p.MaxRetries != 1 || p.InitialInterval.Seconds() != 5 {
+		t.Errorf("retryPolicy failed: unexpected policy %+v", p)
+	}
+	req = d.request("app", &v1.AndroidPushRequestData{TTL: 10, Priority: "normal"})
+	if req.GetTTL() != 10 || req.GetPriority() != "normal" {
+		t.Errorf("request failed: expected request ttl and priority but got %d %s", req.GetTTL(), req.GetPriority())
+	}
+}
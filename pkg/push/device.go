@@ -0,0 +1,73 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/feedback"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// 各厂商设备 token 的格式，只用于过滤明显错误的 token，厂商未公开的格式尽量放宽
+var (
+	apnsTokenFormat   = regexp.MustCompile(`^[0-9a-fA-F]{64,200}$`)
+	fcmTokenFormat    = regexp.MustCompile(`^[\w:\-]{32,1000}$`)
+	hmsTokenFormat    = regexp.MustCompile(`^[\w+/=:.\-]{32,512}$`)
+	honorTokenFormat  = regexp.MustCompile(`^[\w+/=:.\-]{32,512}$`)
+	xiaomiTokenFormat = regexp.MustCompile(`^[A-Za-z0-9+/=]{20,256}$`)
+	vivoTokenFormat   = regexp.MustCompile(`^[\w\-]{16,64}$`)
+	oppoTokenFormat   = regexp.MustCompile(`^[\w\-]{16,128}$`)
+	meizuTokenFormat  = regexp.MustCompile(`^[\w\-]{16,128}$`)
+)
+
+// ValidateFunc 通过厂商接口校验单个 token
+type ValidateFunc func(ctx context.Context, token string) push.DeviceResult
+
+// checkDevices 依次检查 token 格式以及已记录的失效 token，
+// 剩余的 token 交给 validate 校验，validate 为 nil 时结果为 DeviceUnknown
+func checkDevices(ctx context.Context, platform, appid string, tokens []string, format *regexp.Regexp, fb *feedback.Storage, validate ValidateFunc, co *push.CheckDeviceOptions) (*push.CheckDeviceResponse, error) {
+	if len(tokens) == 0 {
+		return nil, errors.New("tokens cannot be empty")
+	}
+
+	if co.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(co.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	var wg sync.WaitGroup
+	var maxConcurrent = make(chan struct{}, 100)
+	resp := &push.CheckDeviceResponse{Results: make([]push.DeviceResult, len(tokens))}
+	for i, token := range tokens {
+		if !format.MatchString(token) {
+			resp.Results[i] = push.DeviceResult{Token: token, Status: push.DeviceInvalid, Reason: "invalid token format"}
+			continue
+		}
+		if t, ok := fb.Get(platform, appid, token); ok {
+			resp.Results[i] = push.DeviceResult{Token: token, Status: push.DeviceInvalid, Reason: t.Reason}
+			continue
+		}
+		if validate == nil {
+			resp.Results[i] = push.DeviceResult{Token: token, Status: push.DeviceUnknown, Reason: "validation not supported by vendor"}
+			continue
+		}
+
+		maxConcurrent <- struct{}{}
+		wg.Add(1)
+		go func(idx int, token string) {
+			defer func() {
+				<-maxConcurrent
+				wg.Done()
+			}()
+			result := validate(ctx, token)
+			result.Token = token
+			resp.Results[idx] = result
+		}(i, token)
+	}
+	wg.Wait()
+
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package push
+
+import (
+	"context"
+	"github.com/cossim/hipush/api/push"
+	"testing"
+)
+
+func TestCheckDevices(t *testing.T) {
+	valid := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	validate := func(ctx context.Context, token string) push.DeviceResult {
+		return push.DeviceResult{Status: push.DeviceValid}
+	}
+
+	resp, err := checkDevices(context.Background(), "ios", "app", []string{valid, "bad token"}, apnsTokenFormat, nil, validate, &push.CheckDeviceOptions{})
+	if err != nil {
+		t.Fatalf("checkDevices failed: %v", err)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("checkDevices failed: expected 2 results but got %d", len(resp.Results))
+	}
+	if resp.Results[0].Status != push.DeviceValid || resp.Results[0].Token != valid {
+		t.Errorf("checkDevices failed: unexpected result %+v", resp.Results[0])
+	}
+	if resp.Results[1].Status != push.DeviceInvalid {
+		t.Errorf("checkDevices failed: expected invalid format but got %+v", resp.Results[1])
+	}
+
+	// Test vendor without validation
+	resp, _ = checkDevices(context.Background(), "ios", "app", []string{valid}, apnsTokenFormat, nil, nil, &push.CheckDeviceOptions{})
+	if resp.Results[0].Status != push.DeviceUnknown {
+		t.Errorf("checkDevices failed: expected unknown but got %+v", resp.Results[0])
+	}
+}
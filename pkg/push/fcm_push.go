@@ -5,14 +5,19 @@ import (
 	"errors"
 	firebase "firebase.google.com/go"
 	"firebase.google.com/go/messaging"
+	"fmt"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/logr"
 	"google.golang.org/api/option"
 	"log"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -24,7 +29,9 @@ var (
 type FCMService struct {
 	clients        map[string]*messaging.Client
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
+	feedback       *feedback.Storage
 	logger         logr.Logger
 }
 
@@ -32,7 +39,9 @@ func NewFCMService(cfg *config.Config, logger logr.Logger) *FCMService {
 	s := &FCMService{
 		clients:        make(map[string]*messaging.Client),
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
+		feedback:       feedback.InvalidTokenStorage,
 		logger:         logger,
 	}
 
@@ -57,8 +66,10 @@ func NewFCMService(cfg *config.Config, logger logr.Logger) *FCMService {
 
 		if v.AppID == "" {
 			s.clients[v.AppName] = client
+			s.defaults[v.AppName] = v.SendConfig
 		} else {
 			s.clients[v.AppID] = client
+			s.defaults[v.AppID] = v.SendConfig
 		}
 
 		if v.AppName != "" {
@@ -74,6 +85,12 @@ func NewFCMService(cfg *config.Config, logger logr.Logger) *FCMService {
 }
 
 func (f *FCMService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return f.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -89,6 +106,7 @@ func (f *FCMService) Send(ctx context.Context, req push.SendRequest, opt ...push
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = f.defaults.request(appid, req)
 
 	if err := f.checkNotification(req); err != nil {
 		return nil, err
@@ -100,37 +118,186 @@ func (f *FCMService) Send(ctx context.Context, req push.SendRequest, opt ...push
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(f.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(f.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return f.send(ctx, appid, token, notification)
 	}
 
-	retrySend, err := RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	retrySend, err := RetrySend(ctx, breakerSend(f.Name(), appid, limitSend(f.Name(), appid, send)), req.GetToken(), f.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, f.retryable), f.defaults.maxConcurrent(appid))
+	f.feedback.AddResults(f.Name(), appid, retrySend.Results)
 	if err != nil {
+		return &push.SendResponse{Results: retrySend.Results}, err
+	}
+
+	return &push.SendResponse{TaskId: retrySend.Data.(string), Results: retrySend.Results}, nil
+}
+
+// fcmMaxMulticastTokens 批量推送时每个分片的设备数量，FCM v1 接口每次只能推送一个设备，
+// 分片内的设备并发推送，分片之间按 Delay 间隔
+const fcmMaxMulticastTokens = 500
+
+func (f *FCMService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return f.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = f.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = f.defaults.request(appid, req)
+
+	if len(req.GetToken()) == 0 {
+		return nil, errors.New("the token must not be empty")
+	}
+
+	notification := f.buildAndroidNotification(req)
+
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(f.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(f.Name(), appid, len(req.GetToken())); err != nil {
 		return nil, err
 	}
 
-	return &push.SendResponse{TaskId: retrySend.Data.(string)}, nil
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		return f.multicast(ctx, appid, tokens, notification)
+	}
+
+	resps, results, err := MulticastSend(ctx, breakerBatchSend(f.Name(), appid, send), req.GetToken(), mo.BatchSize(fcmMaxMulticastTokens), time.Duration(mo.Delay)*time.Millisecond, f.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, f.retryable))
+	f.feedback.AddResults(f.Name(), appid, results)
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	resp := &push.SendResponse{Results: results}
+	for _, r := range resps {
+		if taskID, ok := r.Data.(string); ok && taskID != "" {
+			resp.TaskIDs = append(resp.TaskIDs, taskID)
+		}
+	}
+	if len(resp.TaskIDs) > 0 {
+		resp.TaskId = resp.TaskIDs[0]
+	}
+	return resp, nil
+}
+
+// multicast FCM 已停用旧版批量接口，分片内的设备并发调用 v1 接口逐个推送，每次调用前等待令牌桶，
+// 结果与 tokens 的顺序一一对应，所有设备都推送失败时返回第一个设备的错误
+func (f *FCMService) multicast(ctx context.Context, appid string, tokens []string, notification *messaging.Message) (*Response, error) {
+	if _, ok := f.clients[appid]; !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	resp := &Response{Code: Fail, Results: make([]push.TokenResult, len(tokens))}
+	errs := make([]error, len(tokens))
+	send := limitSend(f.Name(), appid, func(ctx context.Context, token string) (*Response, error) {
+		return f.send(ctx, appid, token, notification)
+	})
+
+	var wg sync.WaitGroup
+	maxConcurrent := make(chan struct{}, f.defaults.maxConcurrent(appid))
+	for i, token := range tokens {
+		maxConcurrent <- struct{}{}
+		wg.Add(1)
+		go func(idx int, token string) {
+			defer func() {
+				<-maxConcurrent
+				wg.Done()
+			}()
+			res, err := send(ctx, token)
+			result := push.TokenResult{Token: token, Success: err == nil}
+			if res != nil {
+				result.Msg = res.Msg
+				result.MessageID = res.MessageID
+				result.InvalidReason = res.InvalidReason
+			}
+			if err != nil {
+				result.Msg = err.Error()
+			}
+			// 每个 goroutine 只写入自己的下标
+			resp.Results[idx] = result
+			errs[idx] = err
+		}(i, token)
+	}
+	wg.Wait()
+
+	var success int
+	for _, r := range resp.Results {
+		if r.Success {
+			success++
+			if resp.Data == nil {
+				resp.Data = r.MessageID
+			}
+		}
+	}
+	log.Printf("fcm multicast success: %d failure: %d", success, len(tokens)-success)
+	if success == 0 && len(errs) > 0 {
+		resp.Msg = errs[0].Error()
+		return resp, errs[0]
+	}
+	resp.Code = Success
+	resp.Msg = fmt.Sprintf("success: %d, failure: %d", success, len(tokens)-success)
+
+	return resp, nil
 }
 
 func (f *FCMService) GetTasksStatus(ctx context.Context, appid string, taskID []string, obj push.TaskObjectList) error {
 	return nil
 }
 
+// invalidReason 根据 FCM 返回的错误判断 token 是否已失效
+func (f *FCMService) invalidReason(err error) string {
+	switch {
+	case messaging.IsRegistrationTokenNotRegistered(err):
+		return feedback.ReasonUnregistered
+	case messaging.IsMismatchedCredential(err):
+		return feedback.ReasonInvalid
+	}
+	return ""
+}
+
 func (f *FCMService) send(ctx context.Context, appid string, token string, notification *messaging.Message) (*Response, error) {
 	client, ok := f.clients[appid]
 	if !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 
 	resp := &Response{Code: Fail}
 
 	f.status.AddAndroidTotal(1)
 
-	notification.Token = token
-	res, err := client.Send(ctx, notification)
+	// 并发推送时共用同一个 notification，复制后再设置设备 token
+	n := *notification
+	n.Token = token
+	res, err := client.Send(ctx, &n)
 	if err != nil {
 		log.Printf("fcm send error: %s", err)
 		f.status.AddAndroidFailed(1)
+		resp.InvalidReason = f.invalidReason(err)
 		if res != "" {
 			resp.Msg = res
 		} else {
@@ -142,11 +309,31 @@ func (f *FCMService) send(ctx context.Context, appid string, token string, notif
 		resp.Code = Success
 		resp.Msg = res
 		resp.Data = res
+		resp.MessageID = res
 	}
 
 	return resp, err
 }
 
+// retryable FCM 内部错误、服务不可用、超出发送频率以及 OAuth access token 失效时可以重试，
+// access token 过期后 SDK 会在下次调用时重新获取
+func (f *FCMService) retryable(res *Response, err error) (bool, time.Duration) {
+	if messaging.IsInternal(err) || messaging.IsServerUnavailable(err) || messaging.IsMessageRateExceeded(err) || fcmUnauthenticated(err) {
+		return true, 0
+	}
+	return DefaultClassifier(res, err)
+}
+
+// fcmUnauthenticated firebase v3 没有 IsUnauthenticated，access token 失效返回的 401 UNAUTHENTICATED
+// 与 APNs 证书无效归为同一个错误码，按状态码及 Google 鉴权失败的消息区分
+func fcmUnauthenticated(err error) bool {
+	if !messaging.IsInvalidAPNSCredentials(err) {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "http error status: 401") && strings.Contains(msg, "invalid authentication credentials")
+}
+
 // checkNotification for check request message
 func (f *FCMService) checkNotification(req push.SendRequest) error {
 	var msg string
@@ -257,6 +444,150 @@ func (f *FCMService) buildAndroidNotification(req push.SendRequest) *messaging.M
 	return notification
 }
 
+// CheckDevice 使用 FCM 的 validate_only 模式校验 token
+func (f *FCMService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), f.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	client, ok := f.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	validate := func(ctx context.Context, token string) push.DeviceResult {
+		_, err := client.SendDryRun(ctx, &messaging.Message{Token: token, Data: map[string]string{"check": "1"}})
+		switch {
+		case err == nil:
+			return push.DeviceResult{Status: push.DeviceValid}
+		case messaging.IsRegistrationTokenNotRegistered(err):
+			return push.DeviceResult{Status: push.DeviceInvalid, Reason: feedback.ReasonUnregistered}
+		case messaging.IsInvalidArgument(err), messaging.IsMismatchedCredential(err):
+			return push.DeviceResult{Status: push.DeviceInvalid, Reason: err.Error()}
+		default:
+			return push.DeviceResult{Status: push.DeviceUnknown, Reason: err.Error()}
+		}
+	}
+
+	return checkDevices(ctx, f.Name(), appid, req.GetToken(), fcmTokenFormat, f.feedback, validate, co)
+}
+
 func (f *FCMService) Name() string {
 	return consts.PlatformAndroid.String()
 }
+
+// fcmMaxTopicTokens FCM 单次订阅或取消订阅最多支持 1000 个 token
+const fcmMaxTopicTokens = 1000
+
+func (f *FCMService) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return f.manageTopic(ctx, req, func(client *messaging.Client, tokens []string) (*messaging.TopicManagementResponse, error) {
+		return client.SubscribeToTopic(ctx, tokens, req.GetTopic())
+	})
+}
+
+func (f *FCMService) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return f.manageTopic(ctx, req, func(client *messaging.Client, tokens []string) (*messaging.TopicManagementResponse, error) {
+		return client.UnsubscribeFromTopic(ctx, tokens, req.GetTopic())
+	})
+}
+
+func (f *FCMService) manageTopic(ctx context.Context, req push.TopicRequest, fn func(client *messaging.Client, tokens []string) (*messaging.TopicManagementResponse, error)) (*push.TopicResponse, error) {
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), f.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkTopicRequest(req); err != nil {
+		return nil, err
+	}
+
+	client, ok := f.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	resp := &push.TopicResponse{}
+	for _, batch := range splitTokens(req.GetToken(), fcmMaxTopicTokens) {
+		res, err := fn(client, batch)
+		if err != nil {
+			log.Printf("fcm topic error: %s", err)
+			resp.Results = append(resp.Results, topicResults(batch, "", "", err)...)
+			continue
+		}
+		results := topicResults(batch, "", "", nil)
+		for _, e := range res.Errors {
+			if e.Index >= 0 && e.Index < len(results) {
+				results[e.Index].Success = false
+				results[e.Index].Msg = e.Reason
+			}
+		}
+		resp.Results = append(resp.Results, results...)
+	}
+
+	return resp, resultsError(resp.Results)
+}
+
+func (f *FCMService) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	to := &push.TopicOptions{}
+	to.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), f.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+	req = f.defaults.request(appid, req)
+
+	if err := checkTopicMessage(topic, req); err != nil {
+		return nil, err
+	}
+
+	client, ok := f.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	notification := f.buildAndroidNotification(req)
+	notification.Topic = strings.TrimPrefix(topic, "/topics/")
+
+	if to.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(f.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(f.Name(), appid, 1); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, _ string) (*Response, error) {
+		resp := &Response{Code: Fail}
+		res, err := client.Send(ctx, notification)
+		if err != nil {
+			log.Printf("fcm topic send error: %s", err)
+			resp.Msg = err.Error()
+			return resp, err
+		}
+		log.Printf("fcm topic send success: %s", res)
+		resp.Code = Success
+		resp.Msg = res
+		resp.MessageID = res
+		return resp, nil
+	}
+
+	resp, err := RetrySend(ctx, breakerSend(f.Name(), appid, limitSend(f.Name(), appid, send)), []string{topic}, f.defaults.retryPolicy(appid, to.Retry, to.RetryInterval, f.retryable), 1)
+	if err != nil {
+		return &push.SendResponse{Results: resp.Results}, err
+	}
+
+	return &push.SendResponse{TaskId: resp.Results[0].MessageID, Results: resp.Results}, nil
+}
+
+// ListTopics FCM 服务端 SDK 不支持查询设备订阅的主题
+func (f *FCMService) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	return nil, push.ErrUnsupported
+}
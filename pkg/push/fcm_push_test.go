@@ -0,0 +1,140 @@
+package push
+
+import (
+	"context"
+	firebase "firebase.google.com/go"
+	"firebase.google.com/go/messaging"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/status"
+	"github.com/cossim/hipush/pkg/store"
+	"google.golang.org/api/option"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func newTestFCMClient(t *testing.T, transport http.RoundTripper) *messaging.Client {
+	app, err := firebase.NewApp(context.Background(), &firebase.Config{ProjectID: "test"}, option.WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		t.Fatalf("firebase.NewApp failed: %v", err)
+	}
+	client, err := app.Messaging(context.Background())
+	if err != nil {
+		t.Fatalf("app.Messaging failed: %v", err)
+	}
+	return client
+}
+
+// newTestFCMService 构造使用模拟 FCM v1 接口的服务，请求体中包含 token bad 时返回设备未注册
+func newTestFCMService(t *testing.T) *FCMService {
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(r.Body)
+		code, data := http.StatusOK, `{"name": "projects/test/messages/1"}`
+		if strings.Contains(string(body), `"token":"bad"`) {
+			code = http.StatusNotFound
+			data = `{"error": {"status": "NOT_FOUND", "message": "Requested entity was not found.", "details": [{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "UNREGISTERED"}]}}`
+		}
+		return &http.Response{StatusCode: code, Header: http.Header{"Content-Type": {"application/json"}}, Body: ioutil.NopCloser(strings.NewReader(data)), Request: r}, nil
+	})
+	return &FCMService{
+		clients:        map[string]*messaging.Client{"app": newTestFCMClient(t, transport)},
+		appNameToIDMap: map[string]string{},
+		defaults:       make(sendDefaults),
+		status:         status.NewStateStorage(store.NewMemoryStore()),
+		feedback:       feedback.NewStorage(store.NewMemoryObjectStore(), nil),
+	}
+}
+
+func TestFCMSendResults(t *testing.T) {
+	f := newTestFCMService(t)
+	invalid := f.feedback
+
+	// Test partial success reports the result of each token
+	req := &v1.AndroidPushRequestData{Meta: &v1.Meta{AppID: "app", Token: []string{"a", "bad", "b"}}, Title: "title", Content: "content"}
+	resp, err := f.Send(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if resp.TaskId != "projects/test/messages/1" {
+		t.Errorf("Send failed: unexpected task id %q", resp.TaskId)
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("Send failed: expected 3 results but got %+v", resp.Results)
+	}
+	for i, token := range req.GetToken() {
+		r := resp.Results[i]
+		if r.Token != token || r.Success != (token != "bad") {
+			t.Errorf("Send failed: unexpected result %+v", r)
+		}
+	}
+	if resp.Results[1].InvalidReason != feedback.ReasonUnregistered {
+		t.Errorf("Send failed: expected unregistered but got %+v", resp.Results[1])
+	}
+	if _, ok := invalid.Get(f.Name(), "app", "bad"); !ok {
+		t.Errorf("Send failed: invalid token is not recorded")
+	}
+}
+
+func TestFCMMulticastResults(t *testing.T) {
+	f := newTestFCMService(t)
+
+	// Test each token is sent through the v1 api and results keep the token order
+	req := &v1.AndroidPushRequestData{Meta: &v1.Meta{AppID: "app", Token: []string{"a", "bad", "b", "c"}}, Title: "title", Content: "content"}
+	resp, err := f.Multicast(context.Background(), req, &push.MulticastOptions{MaxDevices: 2})
+	if err != nil {
+		t.Fatalf("Multicast failed: %v", err)
+	}
+	if len(resp.Results) != 4 {
+		t.Fatalf("Multicast failed: expected 4 results but got %+v", resp.Results)
+	}
+	for i, token := range req.GetToken() {
+		r := resp.Results[i]
+		if r.Token != token || r.Success != (token != "bad") {
+			t.Errorf("Multicast failed: unexpected result %+v", r)
+		}
+	}
+	if resp.Results[1].InvalidReason != feedback.ReasonUnregistered {
+		t.Errorf("Multicast failed: expected unregistered but got %+v", resp.Results[1])
+	}
+
+	// Test a batch where every token fails returns the vendor error
+	req = &v1.AndroidPushRequestData{Meta: &v1.Meta{AppID: "app", Token: []string{"bad"}}, Title: "title", Content: "content"}
+	if _, err := f.Multicast(context.Background(), req); err == nil {
+		t.Errorf("Multicast failed: expected error when all tokens fail")
+	}
+}
+
+func TestFCMRetryable(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		retryable bool
+	}{
+		{"expired access token", `{"error": {"code": 401, "status": "UNAUTHENTICATED", "message": "Request had invalid authentication credentials. Expected OAuth 2 access token, login cookie or other valid authentication credential."}}`, true},
+		{"apns auth error", `{"error": {"code": 401, "status": "UNAUTHENTICATED", "message": "Auth error from APNS or Web Push Service", "details": [{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "APNS_AUTH_ERROR"}]}}`, false},
+	}
+	for _, tt := range tests {
+		body := tt.body
+		client := newTestFCMClient(t, roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusUnauthorized, Header: http.Header{"Content-Type": {"application/json"}}, Body: ioutil.NopCloser(strings.NewReader(body)), Request: r}, nil
+		}))
+		_, err := client.Send(context.Background(), &messaging.Message{Token: "a"})
+		if err == nil {
+			t.Fatalf("%s: Send failed: expected error", tt.name)
+		}
+
+		f := &FCMService{}
+		if retryable, _ := f.retryable(nil, err); retryable != tt.retryable {
+			t.Errorf("%s: retryable(%v) = %v, want %v", tt.name, err, retryable, tt.retryable)
+		}
+	}
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
 	hClient "github.com/cossim/hipush/pkg/client/push"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/logr"
 	"log"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 var (
@@ -20,9 +26,14 @@ var (
 
 // HonorService 荣耀推送，实现了 PushService 接口
 type HonorService struct {
+	// 荣耀不支持主题订阅
+	unsupportedTopicManager
+
 	clients        map[string]*hClient.HonorPushClient
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
+	feedback       *feedback.Storage
 	logger         logr.Logger
 }
 
@@ -30,7 +41,9 @@ func NewHonorService(cfg *config.Config, logger logr.Logger) *HonorService {
 	s := &HonorService{
 		clients:        make(map[string]*hClient.HonorPushClient),
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
+		feedback:       feedback.InvalidTokenStorage,
 		logger:         logger,
 	}
 
@@ -44,8 +57,10 @@ func NewHonorService(cfg *config.Config, logger logr.Logger) *HonorService {
 		client := hClient.NewHonorPush(v.ClientID, v.ClientSecret)
 		if v.AppID == "" {
 			s.clients[v.AppName] = client
+			s.defaults[v.AppName] = v.SendConfig
 		} else {
 			s.clients[v.AppID] = client
+			s.defaults[v.AppID] = v.SendConfig
 		}
 
 		if v.AppName != "" {
@@ -61,6 +76,12 @@ func NewHonorService(cfg *config.Config, logger logr.Logger) *HonorService {
 }
 
 func (h *HonorService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return h.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -76,6 +97,7 @@ func (h *HonorService) Send(ctx context.Context, req push.SendRequest, opt ...pu
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = h.defaults.request(appid, req)
 
 	if err := h.checkNotification(req); err != nil {
 		return nil, err
@@ -87,13 +109,21 @@ func (h *HonorService) Send(ctx context.Context, req push.SendRequest, opt ...pu
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(h.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(h.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return h.send(ctx, appid, token, notification)
 	}
 
-	resp, err := RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	resp, err := RetrySend(ctx, breakerSend(h.Name(), appid, limitSend(h.Name(), appid, send)), req.GetToken(), h.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, h.retryable), h.defaults.maxConcurrent(appid))
+	h.feedback.AddResults(h.Name(), appid, resp.Results)
 	if err != nil {
-		return nil, err
+		return &push.SendResponse{Results: resp.Results}, err
 	}
 
 	taskid, err := h.getTaskIDFromResponse(resp)
@@ -101,7 +131,115 @@ func (h *HonorService) Send(ctx context.Context, req push.SendRequest, opt ...pu
 		return nil, err
 	}
 
-	return &push.SendResponse{TaskId: taskid}, nil
+	return &push.SendResponse{TaskId: taskid, Results: resp.Results}, nil
+}
+
+// honorMaxMulticastTokens 荣耀单次推送最多支持 1000 个 token
+const honorMaxMulticastTokens = 1000
+
+func (h *HonorService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return h.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = h.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = h.defaults.request(appid, req)
+
+	if err := h.checkNotification(req); err != nil {
+		return nil, err
+	}
+
+	notification := h.buildAndroidNotification(req, &push.SendOptions{Development: mo.Development})
+
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(h.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(h.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		return h.multicast(ctx, appid, tokens, notification)
+	}
+
+	resps, results, err := MulticastSend(ctx, breakerBatchSend(h.Name(), appid, limitBatchSend(h.Name(), appid, send)), req.GetToken(), mo.BatchSize(honorMaxMulticastTokens), time.Duration(mo.Delay)*time.Millisecond, h.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, h.retryable))
+	h.feedback.AddResults(h.Name(), appid, results)
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	resp := &push.SendResponse{Results: results}
+	for _, r := range resps {
+		if res, ok := r.Data.(*hClient.SendMessageResponse); ok && res.Data.RequestId != "" {
+			resp.TaskIDs = append(resp.TaskIDs, res.Data.RequestId)
+		}
+	}
+	if len(resp.TaskIDs) > 0 {
+		resp.TaskId = resp.TaskIDs[0]
+	}
+	return resp, nil
+}
+
+func (h *HonorService) multicast(ctx context.Context, appid string, tokens []string, notification *hClient.SendMessageRequest) (*Response, error) {
+	client, ok := h.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	h.status.AddHonorTotal(int64(len(tokens)))
+
+	resp := &Response{Code: Fail}
+	notification.Token = tokens
+	res, err := client.SendMessage(ctx, appid, notification)
+	if res != nil {
+		resp.VendorCode = strconv.Itoa(res.Code)
+		resp.MessageID = res.Data.RequestId
+	}
+	if err != nil {
+		log.Printf("honor multicast error: %s", err)
+		h.status.AddHonorFailed(int64(len(tokens)))
+		resp.Msg = err.Error()
+	} else if res.Code != http.StatusOK {
+		log.Printf("honor multicast error: %s", res.Message)
+		h.status.AddHonorFailed(int64(len(tokens)))
+		err = errors.New(res.Message)
+		resp.Code = res.Code
+		resp.Msg = res.Message
+	} else {
+		failed := len(res.Data.FailTokens) + len(res.Data.ExpireTokens)
+		if failed > 0 {
+			log.Printf("honor multicast fail tokens: %s expire tokens: %s", res.Data.FailTokens, res.Data.ExpireTokens)
+		}
+		log.Printf("honor multicast success: %s", res.Message)
+		h.status.AddHonorSuccess(int64(len(tokens) - failed))
+		h.status.AddHonorFailed(int64(failed))
+		resp.Code = Success
+		resp.Msg = res.Message
+		resp.Data = res
+		resp.Results = h.multicastResults(tokens, res)
+	}
+
+	return resp, err
 }
 
 // getTaskIDFromResponse 从 Response 结构体中获取 task_id 字段
@@ -125,21 +263,68 @@ func (h *HonorService) GetTasksStatus(ctx context.Context, appid string, taskID
 	return nil
 }
 
+// invalidReasons 根据荣耀返回的失败和过期 token 判断 token 是否已失效
+func (h *HonorService) invalidReasons(res *hClient.SendMessageResponse) map[string]string {
+	reasons := make(map[string]string, len(res.Data.FailTokens)+len(res.Data.ExpireTokens))
+	for _, t := range res.Data.FailTokens {
+		reasons[t] = feedback.ReasonInvalid
+	}
+	for _, t := range res.Data.ExpireTokens {
+		reasons[t] = feedback.ReasonExpired
+	}
+	return reasons
+}
+
+// multicastResults 根据荣耀返回的失败和过期 token 生成每个设备的推送结果
+func (h *HonorService) multicastResults(tokens []string, res *hClient.SendMessageResponse) []push.TokenResult {
+	invalid := h.invalidReasons(res)
+
+	results := make([]push.TokenResult, 0, len(tokens))
+	for _, token := range tokens {
+		result := push.TokenResult{
+			Token:     token,
+			Success:   true,
+			Code:      strconv.Itoa(res.Code),
+			Msg:       res.Message,
+			MessageID: res.Data.RequestId,
+		}
+		if reason, ok := invalid[token]; ok {
+			result.Success = false
+			result.Msg = reason + " token"
+			result.InvalidReason = reason
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
 func (h *HonorService) send(ctx context.Context, appid string, token string, notification *hClient.SendMessageRequest) (*Response, error) {
 	client, ok := h.clients[appid]
 	if !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 
 	h.status.AddHonorTotal(1)
 
 	resp := &Response{Code: Fail}
-	notification.Token = []string{token}
-	res, err := client.SendMessage(ctx, appid, notification)
+	// 并发推送时共用同一个 notification，复制后再设置设备 token
+	n := *notification
+	n.Token = []string{token}
+	res, err := client.SendMessage(ctx, appid, &n)
+	if res != nil {
+		resp.VendorCode = strconv.Itoa(res.Code)
+		resp.MessageID = res.Data.RequestId
+		resp.InvalidReason = h.invalidReasons(res)[token]
+	}
 	if err != nil {
 		log.Printf("honor send error: %s", err)
 		h.status.AddHonorFailed(1)
 		resp.Msg = err.Error()
+	} else if resp.InvalidReason != "" {
+		log.Printf("honor send %s token: %s", resp.InvalidReason, token)
+		h.status.AddHonorFailed(1)
+		err = fmt.Errorf("%s token", resp.InvalidReason)
+		resp.Msg = err.Error()
 	} else if res != nil && res.Code != http.StatusOK {
 		if len(res.Data.ExpireTokens) > 0 {
 			log.Printf("honor send expire tokens: %s", res.Data.ExpireTokens)
@@ -207,6 +392,21 @@ func (h *HonorService) send(ctx context.Context, appid string, token string, not
 	//return newTokens, nil
 }
 
+// retryable access token 失效时客户端会清除缓存的 token，重试时重新获取
+func (h *HonorService) retryable(res *Response, err error) (bool, time.Duration) {
+	var he *hClient.HTTPError
+	if errors.As(err, &he) && he.StatusCode == http.StatusUnauthorized {
+		return true, 0
+	}
+	if res != nil {
+		switch res.VendorCode {
+		case strconv.Itoa(hClient.HonorTokenFailedCode), strconv.Itoa(hClient.HonorTokenTimeoutCode):
+			return true, 0
+		}
+	}
+	return DefaultClassifier(res, err)
+}
+
 func (h *HonorService) checkNotification(req push.SendRequest) error {
 	if len(req.GetToken()) == 0 {
 		return errors.New("tokens cannot be empty")
@@ -276,6 +476,19 @@ func (h *HonorService) buildAndroidNotification(req push.SendRequest, so *push.S
 	return sendMessageReq
 }
 
+// CheckDevice 荣耀没有只校验不推送的接口，只检查 token 格式以及已记录的失效 token
+func (h *HonorService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), h.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkDevices(ctx, h.Name(), appid, req.GetToken(), honorTokenFormat, h.feedback, nil, co)
+}
+
 func (h *HonorService) Name() string {
 	return consts.PlatformHonor.String()
 }
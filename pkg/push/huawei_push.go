@@ -5,11 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	c "github.com/cossim/go-hms-push/push/config"
+	"github.com/cossim/go-hms-push/push/constant"
 	hClient "github.com/cossim/go-hms-push/push/core"
 	"github.com/cossim/go-hms-push/push/model"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
+	pushClient "github.com/cossim/hipush/pkg/client/push"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/logr"
 	"log"
@@ -28,16 +33,22 @@ var (
 // HMSService 实现huawei推送，实现 PushService 接口
 type HMSService struct {
 	clients        map[string]*hClient.HMSClient
+	topicClients   map[string]*pushClient.HMSTopicClient
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
+	feedback       *feedback.Storage
 	logger         logr.Logger
 }
 
 func NewHMSService(cfg *config.Config, logger logr.Logger) *HMSService {
 	s := &HMSService{
 		clients:        make(map[string]*hClient.HMSClient),
+		topicClients:   make(map[string]*pushClient.HMSTopicClient),
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
+		feedback:       feedback.InvalidTokenStorage,
 		logger:         logger,
 	}
 
@@ -59,16 +70,23 @@ func NewHMSService(cfg *config.Config, logger logr.Logger) *HMSService {
 		if v.AppID == "" || v.AppSecret == "" {
 			panic("invalid appid or appid push is not enabled")
 		}
-		client, err := hClient.NewHttpClient(&c.Config{
+		conf := &c.Config{
 			AppId:     v.AppID,
 			AppSecret: v.AppSecret,
 			AuthUrl:   AuthUrl,
 			PushUrl:   PushUrl,
-		})
+		}
+		client, err := hClient.NewHttpClient(conf)
+		if err != nil {
+			panic(err)
+		}
+		topicClient, err := pushClient.NewHMSTopicClient(conf)
 		if err != nil {
 			panic(err)
 		}
 		s.clients[v.AppID] = client
+		s.defaults[v.AppID] = v.SendConfig
+		s.topicClients[v.AppID] = topicClient
 		if v.AppName != "" {
 			s.appNameToIDMap[v.AppName] = v.AppID
 		}
@@ -78,6 +96,12 @@ func NewHMSService(cfg *config.Config, logger logr.Logger) *HMSService {
 }
 
 func (h *HMSService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return h.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -93,6 +117,7 @@ func (h *HMSService) Send(ctx context.Context, req push.SendRequest, opt ...push
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = h.defaults.request(appid, req)
 
 	if err := h.checkNotification(req); err != nil {
 		return nil, err
@@ -107,13 +132,21 @@ func (h *HMSService) Send(ctx context.Context, req push.SendRequest, opt ...push
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(h.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(h.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return h.send(ctx, appid, token, notification)
 	}
 
-	resp, err := RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	resp, err := RetrySend(ctx, breakerSend(h.Name(), appid, limitSend(h.Name(), appid, send)), req.GetToken(), h.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, h.retryable), h.defaults.maxConcurrent(appid))
+	h.feedback.AddResults(h.Name(), appid, resp.Results)
 	if err != nil {
-		return nil, err
+		return &push.SendResponse{Results: resp.Results}, err
 	}
 
 	taskid, err := h.getTaskIDFromResponse(resp)
@@ -121,7 +154,163 @@ func (h *HMSService) Send(ctx context.Context, req push.SendRequest, opt ...push
 		return nil, err
 	}
 
-	return &push.SendResponse{TaskId: taskid}, nil
+	return &push.SendResponse{TaskId: taskid, Results: resp.Results}, nil
+}
+
+// hmsMaxMulticastTokens HMS 单次调用最多支持 1000 个 token
+const hmsMaxMulticastTokens = 1000
+
+func (h *HMSService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return h.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = h.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = h.defaults.request(appid, req)
+
+	if err := h.checkNotification(req); err != nil {
+		return nil, err
+	}
+
+	notification, err := h.buildNotification(req, &push.SendOptions{Development: mo.Development})
+	if err != nil {
+		return nil, err
+	}
+
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(h.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(h.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		return h.multicast(ctx, appid, tokens, notification)
+	}
+
+	resps, results, err := MulticastSend(ctx, breakerBatchSend(h.Name(), appid, limitBatchSend(h.Name(), appid, send)), req.GetToken(), mo.BatchSize(hmsMaxMulticastTokens), time.Duration(mo.Delay)*time.Millisecond, h.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, h.retryable))
+	h.feedback.AddResults(h.Name(), appid, results)
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	resp := &push.SendResponse{Results: results}
+	for _, r := range resps {
+		if taskID, err := h.getTaskIDFromResponse(r); err == nil {
+			resp.TaskIDs = append(resp.TaskIDs, taskID)
+		}
+	}
+	if len(resp.TaskIDs) > 0 {
+		resp.TaskId = resp.TaskIDs[0]
+	}
+	return resp, nil
+}
+
+func (h *HMSService) multicast(ctx context.Context, appid string, tokens []string, notification *model.MessageRequest) (*Response, error) {
+	client, ok := h.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	h.status.AddHuaweiTotal(int64(len(tokens)))
+
+	resp := &Response{Code: Fail}
+	notification.Message.Token = tokens
+	res, err := client.SendMessage(ctx, notification)
+	if res != nil {
+		resp.VendorCode = res.Code
+		resp.MessageID = res.RequestId
+		resp.InvalidReason = h.invalidReason(res)
+	}
+	if err != nil {
+		log.Printf("huawei multicast error: %s", err)
+		h.status.AddHuaweiFailed(int64(len(tokens)))
+		resp.Msg = err.Error()
+	} else if res.Code != "80000000" && res.Code != "80100000" {
+		// 80100000 表示部分 token 发送成功，其余 token 非法或已过期
+		log.Printf("huawei multicast error: %s", res.Msg)
+		h.status.AddHuaweiFailed(int64(len(tokens)))
+		err = errors.New(res.Msg)
+		resp.Msg = res.Msg
+	} else {
+		log.Printf("huawei multicast success: %s", res.Msg)
+		resp.Code = Success
+		resp.Msg = res.Msg
+		resp.Data = res
+		resp.Results = h.multicastResults(tokens, res)
+		var failed int
+		for _, r := range resp.Results {
+			if !r.Success {
+				failed++
+			}
+		}
+		h.status.AddHuaweiSuccess(int64(len(tokens) - failed))
+		h.status.AddHuaweiFailed(int64(failed))
+	}
+
+	return resp, err
+}
+
+// multicastResults 生成每个设备的推送结果，
+// 部分成功（80100000）时 msg 中包含非法 token 列表，例如 {"success":1,"failure":1,"illegal_tokens":["xxx"]}
+func (h *HMSService) multicastResults(tokens []string, res *model.MessageResponse) []push.TokenResult {
+	illegal := make(map[string]struct{})
+	if res.Code == "80100000" {
+		var partial struct {
+			IllegalTokens []string `json:"illegal_tokens"`
+		}
+		if err := json.Unmarshal([]byte(res.Msg), &partial); err == nil {
+			for _, t := range partial.IllegalTokens {
+				illegal[t] = struct{}{}
+			}
+		}
+	}
+
+	results := make([]push.TokenResult, 0, len(tokens))
+	for _, token := range tokens {
+		result := push.TokenResult{
+			Token:     token,
+			Success:   true,
+			Code:      res.Code,
+			Msg:       res.Msg,
+			MessageID: res.RequestId,
+		}
+		if _, ok := illegal[token]; ok {
+			result.Success = false
+			result.Msg = "illegal token"
+			result.InvalidReason = feedback.ReasonInvalid
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// invalidReason 根据 HMS 的响应判断 token 是否已失效，80300007 表示所有 token 均无效
+func (h *HMSService) invalidReason(res *model.MessageResponse) string {
+	if res.Code == "80300007" {
+		return feedback.ReasonInvalid
+	}
+	return ""
 }
 
 // getTaskIDFromResponse 从 Response 结构体中获取 RequestId
@@ -148,19 +337,28 @@ func (h *HMSService) GetTasksStatus(ctx context.Context, appid string, taskID []
 func (h *HMSService) send(ctx context.Context, appid string, token string, notification *model.MessageRequest) (*Response, error) {
 	client, ok := h.clients[appid]
 	if !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 
 	h.status.AddHuaweiTotal(1)
 
 	resp := &Response{}
-	notification.Message.Token = []string{token}
-	res, err := client.SendMessage(ctx, notification)
+	// 并发推送时共用同一个 notification，复制后再设置设备 token
+	n := *notification
+	message := *notification.Message
+	message.Token = []string{token}
+	n.Message = &message
+	res, err := client.SendMessage(ctx, &n)
+	if res != nil {
+		resp.VendorCode = res.Code
+		resp.MessageID = res.RequestId
+		resp.InvalidReason = h.invalidReason(res)
+	}
 	if err != nil {
 		log.Printf("huawei send error: %s", err)
 		h.status.AddHuaweiFailed(1)
 		resp.Code = Fail
-		resp.Msg = res.Msg
+		resp.Msg = err.Error()
 	} else if res != nil && res.Code != "80000000" {
 		log.Printf("huawei send error: %s", res.Msg)
 		h.status.AddHonorFailed(1)
@@ -177,6 +375,20 @@ func (h *HMSService) send(ctx context.Context, appid string, token string, notif
 	return resp, err
 }
 
+// hmsInternalErrorCode 华为推送服务内部错误
+const hmsInternalErrorCode = "81000001"
+
+// retryable access token 失效时 SDK 会重新获取 token，可以重试
+func (h *HMSService) retryable(res *Response, err error) (bool, time.Duration) {
+	if res != nil {
+		switch res.VendorCode {
+		case constant.TokenFailedErr, constant.TokenTimeoutErr, hmsInternalErrorCode:
+			return true, 0
+		}
+	}
+	return DefaultClassifier(res, err)
+}
+
 func (h *HMSService) checkNotification(req push.SendRequest) error {
 	if len(req.GetToken()) == 0 {
 		return errors.New("tokens cannot be empty")
@@ -284,6 +496,187 @@ func (h *HMSService) buildNotification(req push.SendRequest, so *push.SendOption
 	return msgRequest, nil
 }
 
+// CheckDevice 使用华为的 validate_only 模式校验 token
+func (h *HMSService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), h.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	client, ok := h.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	validate := func(ctx context.Context, token string) push.DeviceResult {
+		msgRequest := model.NewTransparentMsgRequest()
+		msgRequest.ValidateOnly = true
+		msgRequest.Message.Data = "{}"
+		msgRequest.Message.Token = []string{token}
+		res, err := client.SendMessage(ctx, msgRequest)
+		switch {
+		case err != nil:
+			return push.DeviceResult{Status: push.DeviceUnknown, Reason: err.Error()}
+		case res.Code == "80000000":
+			return push.DeviceResult{Status: push.DeviceValid}
+		case h.invalidReason(res) != "":
+			return push.DeviceResult{Status: push.DeviceInvalid, Reason: res.Msg}
+		default:
+			return push.DeviceResult{Status: push.DeviceUnknown, Reason: res.Msg}
+		}
+	}
+
+	return checkDevices(ctx, h.Name(), appid, req.GetToken(), hmsTokenFormat, h.feedback, validate, co)
+}
+
 func (h *HMSService) Name() string {
 	return consts.PlatformHuawei.String()
 }
+
+// hmsMaxTopicTokens 华为单次订阅或取消订阅最多支持 1000 个 token
+const hmsMaxTopicTokens = 1000
+
+func (h *HMSService) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return h.manageTopic(ctx, req, (*pushClient.HMSTopicClient).Subscribe)
+}
+
+func (h *HMSService) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return h.manageTopic(ctx, req, (*pushClient.HMSTopicClient).Unsubscribe)
+}
+
+func (h *HMSService) manageTopic(ctx context.Context, req push.TopicRequest, fn func(client *pushClient.HMSTopicClient, ctx context.Context, req *pushClient.TopicRequest) (*pushClient.TopicResponse, error)) (*push.TopicResponse, error) {
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), h.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkTopicRequest(req); err != nil {
+		return nil, err
+	}
+
+	client, ok := h.topicClients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	resp := &push.TopicResponse{}
+	for _, batch := range splitTokens(req.GetToken(), hmsMaxTopicTokens) {
+		res, err := fn(client, ctx, &pushClient.TopicRequest{Topic: req.GetTopic(), TokenArray: batch})
+		if err != nil {
+			log.Printf("huawei topic error: %s", err)
+			resp.Results = append(resp.Results, topicResults(batch, "", "", err)...)
+			continue
+		}
+		results := topicResults(batch, res.Code, res.Msg, nil)
+		failed := make(map[string]string, len(res.Errors))
+		for _, e := range res.Errors {
+			failed[e.Token] = e.ErrorCode
+		}
+		for i := range results {
+			if code, ok := failed[results[i].Token]; ok {
+				results[i].Success = false
+				results[i].Code = code
+			}
+		}
+		resp.Results = append(resp.Results, results...)
+	}
+
+	return resp, resultsError(resp.Results)
+}
+
+func (h *HMSService) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	to := &push.TopicOptions{}
+	to.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), h.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+	req = h.defaults.request(appid, req)
+
+	if err := checkTopicMessage(topic, req); err != nil {
+		return nil, err
+	}
+
+	client, ok := h.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	notification, err := h.buildNotification(req, &push.SendOptions{Development: to.Development})
+	if err != nil {
+		return nil, err
+	}
+	notification.Message.Token = nil
+	notification.Message.Topic = topic
+
+	if to.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(h.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(h.Name(), appid, 1); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, _ string) (*Response, error) {
+		resp := &Response{Code: Fail}
+		res, err := client.SendMessage(ctx, notification)
+		if res != nil {
+			resp.VendorCode = res.Code
+			resp.MessageID = res.RequestId
+			resp.Msg = res.Msg
+		}
+		if err != nil {
+			log.Printf("huawei topic send error: %s", err)
+			resp.Msg = err.Error()
+			return resp, err
+		}
+		if res.Code != "80000000" {
+			log.Printf("huawei topic send error: %s", res.Msg)
+			return resp, errors.New(res.Msg)
+		}
+		log.Printf("huawei topic send success: %s", res)
+		resp.Code = Success
+		return resp, nil
+	}
+
+	resp, err := RetrySend(ctx, breakerSend(h.Name(), appid, limitSend(h.Name(), appid, send)), []string{topic}, h.defaults.retryPolicy(appid, to.Retry, to.RetryInterval, h.retryable), 1)
+	if err != nil {
+		return &push.SendResponse{Results: resp.Results}, err
+	}
+
+	return &push.SendResponse{TaskId: resp.Results[0].MessageID, Results: resp.Results}, nil
+}
+
+func (h *HMSService) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), h.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetToken() == "" {
+		return nil, errors.New("token cannot be empty")
+	}
+
+	client, ok := h.topicClients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	res, err := client.List(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]string, 0, len(res.Topics))
+	for _, t := range res.Topics {
+		topics = append(topics, t.Name)
+	}
+	return topics, nil
+}
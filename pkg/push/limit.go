@@ -0,0 +1,26 @@
+package push
+
+import (
+	"context"
+	"github.com/cossim/hipush/pkg/ratelimit"
+)
+
+// limitSend 每次调用厂商接口前等待平台及应用的令牌桶
+func limitSend(platform, appID string, send SendFunc) SendFunc {
+	return func(ctx context.Context, token string) (*Response, error) {
+		if err := ratelimit.PushLimiter.Wait(ctx, platform, appID); err != nil {
+			return nil, err
+		}
+		return send(ctx, token)
+	}
+}
+
+// limitBatchSend 每次调用厂商批量接口前等待平台及应用的令牌桶
+func limitBatchSend(platform, appID string, send BatchSendFunc) BatchSendFunc {
+	return func(ctx context.Context, tokens []string) (*Response, error) {
+		if err := ratelimit.PushLimiter.Wait(ctx, platform, appID); err != nil {
+			return nil, err
+		}
+		return send(ctx, tokens)
+	}
+}
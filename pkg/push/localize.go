@@ -0,0 +1,174 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/push"
+	"sort"
+	"strings"
+)
+
+// localeFallbacks 地区语言回退的语言，在回退到上一级语言之前使用，例如 zh-HK → zh-TW → zh
+var localeFallbacks = map[string]string{
+	"zh-hk":   "zh-tw",
+	"zh-mo":   "zh-tw",
+	"zh-hant": "zh-tw",
+	"zh-sg":   "zh-cn",
+	"zh-hans": "zh-cn",
+}
+
+// LocaleChain 返回语言的回退链，依次为语言本身、地区回退语言及去掉最后一个子标签后的上一级语言，
+// 语言标签统一为小写并使用 - 分隔，例如 zh_HK 返回 zh-hk、zh-tw、zh
+func LocaleChain(locale string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(l string) {
+		if !seen[l] {
+			seen[l] = true
+			chain = append(chain, l)
+		}
+	}
+
+	l := normalizeLocale(locale)
+	for l != "" {
+		add(l)
+		if fallback, ok := localeFallbacks[l]; ok {
+			add(fallback)
+		}
+		i := strings.LastIndex(l, "-")
+		if i < 0 {
+			break
+		}
+		l = l[:i]
+	}
+	return chain
+}
+
+// MatchLocale 按回退链在 available 中查找与 locale 最匹配的语言，available 的 key 需为 normalizeLocale 后的语言标签，
+// 没有匹配的语言时返回空字符串
+func MatchLocale(locale string, available map[string]push.LocalizedContent) string {
+	for _, l := range LocaleChain(locale) {
+		if _, ok := available[l]; ok {
+			return l
+		}
+	}
+	return ""
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// localeRequest 使用指定语言的标题及内容推送给一组设备的请求
+type localeRequest struct {
+	push.SendRequest
+	tokens  []string
+	title   string
+	content string
+}
+
+func (r *localeRequest) GetToken() []string {
+	return r.tokens
+}
+
+func (r *localeRequest) GetTitle() string {
+	if r.title != "" {
+		return r.title
+	}
+	return r.SendRequest.GetTitle()
+}
+
+func (r *localeRequest) GetContent() string {
+	if r.content != "" {
+		return r.content
+	}
+	return r.SendRequest.GetContent()
+}
+
+// localize 推送请求携带多语言内容时按设备的语言将设备分组，返回每组使用最匹配语言的标题及内容的推送请求，
+// 请求不需要按语言推送时返回 nil
+func localize(req push.SendRequest) []push.SendRequest {
+	lr, ok := req.(push.LocalizedRequest)
+	if !ok || len(lr.GetLocalized()) == 0 {
+		return nil
+	}
+
+	contents := make(map[string]push.LocalizedContent, len(lr.GetLocalized()))
+	for l, c := range lr.GetLocalized() {
+		contents[normalizeLocale(l)] = c
+	}
+
+	groups := make(map[string][]string)
+	for _, token := range req.GetToken() {
+		l := MatchLocale(lr.GetTokenLocales()[token], contents)
+		groups[l] = append(groups[l], token)
+	}
+	locales := make([]string, 0, len(groups))
+	for l := range groups {
+		locales = append(locales, l)
+	}
+	sort.Strings(locales)
+
+	reqs := make([]push.SendRequest, 0, len(locales))
+	for _, l := range locales {
+		c := contents[l]
+		reqs = append(reqs, &localeRequest{SendRequest: req, tokens: groups[l], title: c.Title, content: c.Content})
+	}
+	return reqs
+}
+
+// sendLocalized 依次推送每种语言的请求并合并推送结果，结果按原请求的 token 顺序排列，
+// 只有所有语言都推送失败时才返回错误
+func sendLocalized(ctx context.Context, tokens []string, reqs []push.SendRequest, send func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error)) (*push.SendResponse, error) {
+	var resp *push.SendResponse
+	var errs []error
+	var success bool
+	for _, r := range reqs {
+		res, err := send(ctx, r)
+		if err == nil {
+			success = true
+		} else {
+			errs = append(errs, err)
+		}
+		if res == nil && err == nil {
+			// 只校验不推送
+			continue
+		}
+		if resp == nil {
+			resp = &push.SendResponse{}
+		}
+		if res == nil {
+			// 推送前校验失败，厂商没有返回设备的推送结果
+			for _, t := range r.GetToken() {
+				result := push.TokenResult{Token: t, Msg: err.Error()}
+				resp.Results = append(resp.Results, result)
+				push.ReportProgress(ctx, result)
+			}
+			continue
+		}
+		if resp.TaskId == "" {
+			resp.TaskId = res.TaskId
+		}
+		if len(res.TaskIDs) == 0 && res.TaskId != "" {
+			resp.TaskIDs = append(resp.TaskIDs, res.TaskId)
+		}
+		resp.TaskIDs = append(resp.TaskIDs, res.TaskIDs...)
+		resp.Results = append(resp.Results, res.Results...)
+	}
+
+	if resp != nil {
+		order := make(map[string]int, len(tokens))
+		for i, t := range tokens {
+			if _, ok := order[t]; !ok {
+				order[t] = i
+			}
+		}
+		sort.SliceStable(resp.Results, func(i, j int) bool {
+			return order[resp.Results[i].Token] < order[resp.Results[j].Token]
+		})
+	}
+	if !success {
+		return resp, errors.Join(errs...)
+	}
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package push
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/cossim/hipush/api/pb/v1"
+	"github.com/cossim/hipush/api/push"
+	"reflect"
+	"testing"
+)
+
+func TestLocaleChain(t *testing.T) {
+	tests := map[string][]string{
+		"zh-HK":      {"zh-hk", "zh-tw", "zh"},
+		"zh_Hant_HK": {"zh-hant-hk", "zh-hant", "zh-tw", "zh"},
+		"en-US":      {"en-us", "en"},
+		"ja":         {"ja"},
+		"":           nil,
+	}
+	for locale, expected := range tests {
+		if chain := LocaleChain(locale); !reflect.DeepEqual(chain, expected) {
+			t.Errorf("LocaleChain(%q): expected %v but got %v", locale, expected, chain)
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	r := &v1.AndroidPushRequestData{Meta: &v1.Meta{Token: []string{"hk", "en", "fr", "cn"}}, Title: "Hello", Content: "default"}
+	req := push.WithLocalization(r, map[string]push.LocalizedContent{
+		"zh-TW": {Title: "你好", Content: "繁體"},
+		"zh":    {Title: "你好", Content: "简体"},
+		"en":    {Content: "english"},
+	}, map[string]string{"hk": "zh-HK", "en": "en-GB", "fr": "fr", "cn": "zh-CN"})
+
+	contents := make(map[string]string)
+	send := func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+		resp := &push.SendResponse{TaskId: req.GetContent()}
+		for _, token := range req.GetToken() {
+			contents[token] = req.GetTitle() + " " + req.GetContent()
+			resp.Results = append(resp.Results, push.TokenResult{Token: token, Success: true})
+		}
+		return resp, nil
+	}
+
+	// Test each device receives the best matching locale
+	reqs := localize(req)
+	if len(reqs) != 4 {
+		t.Fatalf("localize failed: expected 4 groups but got %d", len(reqs))
+	}
+	resp, err := sendLocalized(context.Background(), r.GetToken(), reqs, send)
+	if err != nil {
+		t.Fatalf("sendLocalized failed: %v", err)
+	}
+	expected := map[string]string{"hk": "你好 繁體", "en": "Hello english", "fr": "Hello default", "cn": "你好 简体"}
+	if !reflect.DeepEqual(contents, expected) {
+		t.Errorf("sendLocalized failed: expected %v but got %v", expected, contents)
+	}
+	for i, token := range r.GetToken() {
+		if resp.Results[i].Token != token {
+			t.Errorf("sendLocalized failed: results not in token order %+v", resp.Results)
+		}
+	}
+	if len(resp.TaskIDs) != 4 {
+		t.Errorf("sendLocalized failed: expected 4 task ids but got %v", resp.TaskIDs)
+	}
+
+	// Test request without localized content is not split
+	if reqs := localize(r); reqs != nil {
+		t.Errorf("localize failed: expected nil but got %d groups", len(reqs))
+	}
+
+	// Test a failed group does not fail the others
+	failed := errors.New("invalid")
+	resp, err = sendLocalized(context.Background(), r.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+		if req.GetContent() == "english" {
+			return nil, failed
+		}
+		return send(ctx, req)
+	})
+	if err != nil || len(resp.Results) != 4 || resp.Results[1].Success || resp.Results[1].Msg != "invalid" {
+		t.Errorf("sendLocalized partial failure: unexpected response %+v, err %v", resp, err)
+	}
+}
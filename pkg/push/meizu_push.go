@@ -8,10 +8,16 @@ import (
 	v1 "github.com/cossim/hipush/api/pb/v1"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/logr"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 )
 
 var (
@@ -20,8 +26,12 @@ var (
 
 // MeizuService 实现魅族推送，实现 PushService 接口
 type MeizuService struct {
+	// 魅族 SDK 不支持按标签推送
+	unsupportedTopicManager
+
 	clients        map[string]func(token, message string) mzp.PushResponse
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
 	logger         logr.Logger
 }
@@ -30,6 +40,7 @@ func NewMeizuService(cfg *config.Config, logger logr.Logger) *MeizuService {
 	s := &MeizuService{
 		clients:        make(map[string]func(token, message string) mzp.PushResponse),
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
 		logger:         logger,
 	}
@@ -46,6 +57,7 @@ func NewMeizuService(cfg *config.Config, logger logr.Logger) *MeizuService {
 			appkey := v.AppKey
 			return mzp.PushNotificationMessageByPushId(appid, token, message, appkey)
 		}
+		s.defaults[v.AppID] = v.SendConfig
 		if v.AppName != "" {
 			s.appNameToIDMap[v.AppName] = v.AppID
 		}
@@ -55,6 +67,12 @@ func NewMeizuService(cfg *config.Config, logger logr.Logger) *MeizuService {
 }
 
 func (m *MeizuService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return m.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -70,6 +88,7 @@ func (m *MeizuService) Send(ctx context.Context, req push.SendRequest, opt ...pu
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = m.defaults.request(appid, req)
 
 	if err := m.checkNotification(req); err != nil {
 		return nil, err
@@ -84,16 +103,82 @@ func (m *MeizuService) Send(ctx context.Context, req push.SendRequest, opt ...pu
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(m.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(m.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return m.send(appid, token, notification)
 	}
 
-	_, err = RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	resp, err := RetrySend(ctx, breakerSend(m.Name(), appid, limitSend(m.Name(), appid, send)), req.GetToken(), m.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, m.retryable), m.defaults.maxConcurrent(appid))
+	if err != nil {
+		return &push.SendResponse{Results: resp.Results}, err
+	}
+
+	return &push.SendResponse{Results: resp.Results}, nil
+}
+
+// meizuMaxMulticastTokens 魅族单次推送最多支持 1000 个 pushId
+const meizuMaxMulticastTokens = 1000
+
+func (m *MeizuService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return m.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = m.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = m.defaults.request(appid, req)
+
+	if err := m.checkNotification(req); err != nil {
+		return nil, err
+	}
+
+	notification, err := m.buildNotification(req)
 	if err != nil {
 		return nil, err
 	}
 
-	return &push.SendResponse{}, nil
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(m.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(m.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		return m.multicast(appid, tokens, notification)
+	}
+
+	_, results, err := MulticastSend(ctx, breakerBatchSend(m.Name(), appid, limitBatchSend(m.Name(), appid, send)), req.GetToken(), mo.BatchSize(meizuMaxMulticastTokens), time.Duration(mo.Delay)*time.Millisecond, m.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, m.retryable))
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	return &push.SendResponse{Results: results}, nil
 }
 
 func (m *MeizuService) GetTasksStatus(ctx context.Context, appid string, taskID []string, obj push.TaskObjectList) error {
@@ -103,7 +188,7 @@ func (m *MeizuService) GetTasksStatus(ctx context.Context, appid string, taskID
 func (m *MeizuService) send(appid string, token string, message string) (*Response, error) {
 	pushFunc, ok := m.clients[appid]
 	if !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 
 	m.status.AddMeizuTotal(1)
@@ -111,6 +196,7 @@ func (m *MeizuService) send(appid string, token string, message string) (*Respon
 	var err error
 	resp := &Response{}
 	res := pushFunc(token, message)
+	resp.VendorCode = strconv.Itoa(res.GetCode())
 	if res.GetCode() != Success {
 		log.Printf("meizu send error: %s", res.GetMessage())
 		m.status.AddMeizuFailed(1)
@@ -128,6 +214,44 @@ func (m *MeizuService) send(appid string, token string, message string) (*Respon
 	return resp, err
 }
 
+// multicast 魅族使用逗号分隔的 pushId 列表进行批量推送
+func (m *MeizuService) multicast(appid string, tokens []string, message string) (*Response, error) {
+	pushFunc, ok := m.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	m.status.AddMeizuTotal(int64(len(tokens)))
+
+	var err error
+	resp := &Response{}
+	res := pushFunc(strings.Join(tokens, ","), message)
+	resp.VendorCode = strconv.Itoa(res.GetCode())
+	if res.GetCode() != Success {
+		log.Printf("meizu multicast error: %s", res.GetMessage())
+		m.status.AddMeizuFailed(int64(len(tokens)))
+		err = errors.New(res.GetMessage())
+		resp.Code = Fail
+		resp.Msg = res.GetMessage()
+	} else {
+		log.Printf("meizu multicast success code: %v msg: %s", res.GetCode(), res.GetMessage())
+		m.status.AddMeizuSuccess(int64(len(tokens)))
+		resp.Code = Success
+		resp.Msg = res.GetMessage()
+		resp.Data = res
+	}
+
+	return resp, err
+}
+
+// retryable 魅族 SDK 请求失败（网络错误等）时返回码为 0
+func (m *MeizuService) retryable(res *Response, err error) (bool, time.Duration) {
+	if res != nil && res.VendorCode == "0" {
+		return true, 0
+	}
+	return DefaultClassifier(res, err)
+}
+
 func (m *MeizuService) checkNotification(req push.SendRequest) error {
 	if len(req.GetToken()) == 0 {
 		return errors.New("tokens cannot be empty")
@@ -175,6 +299,19 @@ func (m *MeizuService) buildNotification(req push.SendRequest) (string, error) {
 	return string(message), nil
 }
 
+// CheckDevice 魅族没有只校验不推送的接口，只检查 token 格式以及已记录的失效 token
+func (m *MeizuService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), m.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkDevices(ctx, m.Name(), appid, req.GetToken(), meizuTokenFormat, feedback.InvalidTokenStorage, nil, co)
+}
+
 func (m *MeizuService) Name() string {
 	return consts.PlatformMeizu.String()
 }
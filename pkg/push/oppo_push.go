@@ -8,11 +8,16 @@ import (
 	op "github.com/316014408/oppo-push"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/jsonpb"
 	"log"
+	"strconv"
+	"time"
 )
 
 var (
@@ -21,8 +26,12 @@ var (
 
 // OppoService 实现oppo推送，实现 PushService 接口
 type OppoService struct {
+	// oppo 不支持主题订阅
+	unsupportedTopicManager
+
 	clients        map[string]*op.OppoPush
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
 	logger         logr.Logger
 }
@@ -31,6 +40,7 @@ func NewOppoService(cfg *config.Config, logger logr.Logger) *OppoService {
 	s := &OppoService{
 		clients:        map[string]*op.OppoPush{},
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
 		logger:         logger,
 	}
@@ -45,8 +55,10 @@ func NewOppoService(cfg *config.Config, logger logr.Logger) *OppoService {
 		client := op.NewClient(v.AppKey, v.AppSecret)
 		if v.AppID == "" {
 			s.clients[v.AppName] = client
+			s.defaults[v.AppName] = v.SendConfig
 		} else {
 			s.clients[v.AppID] = client
+			s.defaults[v.AppID] = v.SendConfig
 		}
 
 		if v.AppName != "" {
@@ -62,6 +74,12 @@ func NewOppoService(cfg *config.Config, logger logr.Logger) *OppoService {
 }
 
 func (o *OppoService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return o.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -77,6 +95,7 @@ func (o *OppoService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = o.defaults.request(appid, req)
 
 	if err := o.checkNotification(req); err != nil {
 		return nil, err
@@ -91,13 +110,20 @@ func (o *OppoService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(o.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(o.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return o.send(appid, token, notification)
 	}
 
-	resp, err := RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	resp, err := RetrySend(ctx, breakerSend(o.Name(), appid, limitSend(o.Name(), appid, send)), req.GetToken(), o.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, o.retryable), o.defaults.maxConcurrent(appid))
 	if err != nil {
-		return nil, err
+		return &push.SendResponse{Results: resp.Results}, err
 	}
 
 	taskid, err := o.getTaskIDFromResponse(resp)
@@ -105,7 +131,133 @@ func (o *OppoService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 		return nil, err
 	}
 
-	return &push.SendResponse{TaskId: taskid}, nil
+	return &push.SendResponse{TaskId: taskid, Results: resp.Results}, nil
+}
+
+// oppoMaxMulticastTokens oppo 批量单推单次最多支持 1000 条消息
+const oppoMaxMulticastTokens = 1000
+
+func (o *OppoService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return o.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = o.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = o.defaults.request(appid, req)
+
+	if err := o.checkNotification(req); err != nil {
+		return nil, err
+	}
+
+	notification, err := o.buildNotification(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(o.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(o.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		return o.multicast(appid, tokens, notification)
+	}
+
+	resps, results, err := MulticastSend(ctx, breakerBatchSend(o.Name(), appid, limitBatchSend(o.Name(), appid, send)), req.GetToken(), mo.BatchSize(oppoMaxMulticastTokens), time.Duration(mo.Delay)*time.Millisecond, o.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, o.retryable))
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	resp := &push.SendResponse{Results: results}
+	for _, r := range resps {
+		res, ok := r.Data.(*op.UnicastBatchSendResult)
+		if !ok {
+			continue
+		}
+		for _, d := range res.Data {
+			if d.MessageID != "" {
+				resp.TaskIDs = append(resp.TaskIDs, d.MessageID)
+				break
+			}
+		}
+	}
+	if len(resp.TaskIDs) > 0 {
+		resp.TaskId = resp.TaskIDs[0]
+	}
+	return resp, nil
+}
+
+// multicast 使用 oppo 批量单推接口，为每个 registration_id 生成一条消息后一次性提交
+func (o *OppoService) multicast(appID string, tokens []string, notification *op.Message) (*Response, error) {
+	client, ok := o.clients[appID]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	o.status.AddOppoTotal(int64(len(tokens)))
+
+	messages := make([]op.Message, 0, len(tokens))
+	for _, token := range tokens {
+		m := *notification
+		m.SetTargetValue(token)
+		messages = append(messages, m)
+	}
+
+	resp := &Response{Code: Fail}
+	res, err := client.UnicastBatch(messages)
+	if err != nil {
+		log.Printf("oppo multicast error: %s", err)
+		o.status.AddOppoFailed(int64(len(tokens)))
+		resp.Msg = err.Error()
+		return resp, err
+	}
+
+	var failed int
+	for _, d := range res.Data {
+		result := push.TokenResult{
+			Token:     d.RegistrationID,
+			Success:   d.ErrorCode == 0,
+			Code:      strconv.Itoa(d.ErrorCode),
+			Msg:       d.ErrorMessage,
+			MessageID: d.MessageID,
+		}
+		if d.ErrorCode != 0 {
+			log.Printf("oppo multicast error: %s registrationId: %s", d.ErrorMessage, d.RegistrationID)
+			failed++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	log.Printf("oppo multicast success: %d failure: %d", len(tokens)-failed, failed)
+	o.status.AddOppoSuccess(int64(len(tokens) - failed))
+	o.status.AddOppoFailed(int64(failed))
+	resp.Code = Success
+	resp.Msg = res.Message
+	resp.Data = res
+	resp.VendorCode = strconv.Itoa(res.Code)
+
+	return resp, nil
 }
 
 // getTaskIDFromResponse 从 Response 结构体中获取 RequestId
@@ -136,14 +288,20 @@ func (o *OppoService) GetTasksStatus(ctx context.Context, appid string, taskID [
 func (o *OppoService) send(appID string, token string, notification *op.Message) (*Response, error) {
 	client, ok := o.clients[appID]
 	if !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 
 	o.status.AddOppoTotal(1)
 
 	resp := &Response{Code: Fail}
-	notification.SetTargetValue(token)
-	res, err := client.Unicast(notification)
+	// 并发推送时共用同一个 notification，复制后再设置设备 token
+	n := *notification
+	n.SetTargetValue(token)
+	res, err := client.Unicast(&n)
+	if res != nil {
+		resp.VendorCode = strconv.Itoa(res.Code)
+		resp.MessageID = res.Data.MessageID
+	}
 	if err != nil {
 		log.Printf("oppo send error: %s", err)
 		o.status.AddOppoFailed(1)
@@ -165,6 +323,26 @@ func (o *OppoService) send(appID string, token string, notification *op.Message)
 	return resp, err
 }
 
+// oppo 服务不可用、流量控制及 auth_token 无效的返回码
+const (
+	oppoServiceUnavailableCode = -1
+	oppoFlowControlCode        = -2
+	oppoInvalidAuthTokenCode   = 11
+)
+
+// retryable oppo 服务不可用及触发流量控制时可以重试。
+// oppo-push 按鉴权返回的创建时间缓存 auth_token 24 小时，缓存到期前后的推送可能返回 auth_token 无效，
+// 重试时 SDK 已重新鉴权
+func (o *OppoService) retryable(res *Response, err error) (bool, time.Duration) {
+	if res != nil {
+		switch res.VendorCode {
+		case strconv.Itoa(oppoServiceUnavailableCode), strconv.Itoa(oppoFlowControlCode), strconv.Itoa(oppoInvalidAuthTokenCode):
+			return true, 0
+		}
+	}
+	return DefaultClassifier(res, err)
+}
+
 func (o *OppoService) checkNotification(req push.SendRequest) error {
 	if len(req.GetToken()) == 0 {
 		return errors.New("tokens cannot be empty")
@@ -204,6 +382,19 @@ func (o *OppoService) buildNotification(req push.SendRequest) (*op.Message, erro
 	return m, nil
 }
 
+// CheckDevice oppo 没有只校验不推送的接口，只检查 token 格式以及已记录的失效 token
+func (o *OppoService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), o.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkDevices(ctx, o.Name(), appid, req.GetToken(), oppoTokenFormat, feedback.InvalidTokenStorage, nil, co)
+}
+
 func (o *OppoService) Name() string {
 	return consts.PlatformOppo.String()
 }
@@ -3,6 +3,7 @@ package push
 import (
 	"context"
 	"errors"
+	"github.com/cossim/hipush/api/push"
 	"log"
 	"strings"
 	"sync"
@@ -17,6 +18,15 @@ type Response struct {
 	Code int         `json:"code"`
 	Msg  string      `json:"msg"`
 	Data interface{} `json:"data"`
+
+	// VendorCode 厂商返回的原始状态码
+	VendorCode string `json:"vendor_code,omitempty"`
+	// MessageID 厂商返回的消息id
+	MessageID string `json:"message_id,omitempty"`
+	// InvalidReason 厂商反馈 token 已失效时的失效原因
+	InvalidReason string `json:"invalid_reason,omitempty"`
+	// Results 每个设备的推送结果
+	Results []push.TokenResult `json:"results,omitempty"`
 }
 
 const (
@@ -26,62 +36,170 @@ const (
 
 type SendFunc func(ctx context.Context, token string) (*Response, error)
 
-func RetrySend(ctx context.Context, send SendFunc, tokens []string, retry int32, retryInterval int32, maxConcurrent int) (*Response, error) {
+// RetrySend 并发地将消息发送给每个 token，失败时按 policy 重试，policy 为 nil 时不重试。
+// 返回的 Response.Results 按 tokens 的顺序记录每个设备的推送结果，
+// 只有全部设备都推送失败时才返回错误，部分成功视为成功。
+func RetrySend(ctx context.Context, send SendFunc, tokens []string, policy *RetryPolicy, maxConcurrent int) (*Response, error) {
 	var wg sync.WaitGroup
-	var resp = &Response{}
-	if retryInterval <= 0 {
-		retryInterval = 1
-	}
+	var mu sync.Mutex
+	var resp = &Response{Code: Fail, Results: make([]push.TokenResult, len(tokens))}
 	if maxConcurrent <= 0 {
 		maxConcurrent = 100
 	}
 	var MaxConcurrentPushes = make(chan struct{}, maxConcurrent)
-	var es []error
 
-	for _, token := range tokens {
+	for i, token := range tokens {
 		// occupy push slot
 		MaxConcurrentPushes <- struct{}{}
 		wg.Add(1)
-		go func(token string) {
+		go func(idx int, token string) {
 			defer func() {
 				// free push slot
 				<-MaxConcurrentPushes
 				wg.Done()
 			}()
-			for i := 0; i <= int(retry); i++ {
-				res, err := send(ctx, token)
-				if err != nil || (res != nil && res.Code != 200) {
-					if err == nil {
-						err = errors.New(res.Msg)
-					} else {
-						es = append(es, err)
-					}
-					if i == 0 {
-						continue
-					}
-					log.Printf("send error: %s (attempt %d)", err, i)
-					time.Sleep(time.Duration(retryInterval) * time.Second)
-				} else {
-					log.Printf("send success: %s", res.Msg)
-					resp.Data = res.Data
-					break
-				}
+			res, attempts, err := policy.Do(ctx, func(ctx context.Context) (*Response, error) {
+				return send(ctx, token)
+			})
+			result := push.TokenResult{Token: token, Success: err == nil, Attempts: attempts}
+			if res != nil {
+				result.Code = res.VendorCode
+				result.Msg = res.Msg
+				result.MessageID = res.MessageID
+				result.InvalidReason = res.InvalidReason
+			}
+			if err != nil {
+				log.Printf("send error: %s (attempts %d)", err, attempts)
+				result.Msg = err.Error()
+			} else {
+				log.Printf("send success: %s", res.Msg)
+				mu.Lock()
+				resp.Code = Success
+				resp.Data = res.Data
+				mu.Unlock()
 			}
-		}(token)
+			// 每个 goroutine 只写入自己的下标
+			resp.Results[idx] = result
+			push.ReportProgress(ctx, result)
+		}(i, token)
 	}
 	wg.Wait()
-	if len(es) > 0 {
-		uniqueErrors := make(map[string]struct{})
-		for _, err := range es {
-			uniqueErrors[err.Error()] = struct{}{}
+
+	if err := resultsError(resp.Results); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// resultsError 在所有设备都推送失败时返回合并后的错误
+func resultsError(results []push.TokenResult) error {
+	var es []string
+	for _, r := range results {
+		if r.Success {
+			return nil
+		}
+		es = append(es, r.Msg)
+	}
+	if len(es) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(uniqueStrings(es), ", "))
+}
+
+// BatchSendFunc 使用厂商的批量接口将消息发送给一组设备
+type BatchSendFunc func(ctx context.Context, tokens []string) (*Response, error)
+
+// MulticastSend 将 tokens 按 batchSize 分片后依次调用 send，
+// 每个分片失败时按 policy 重试，policy 为 nil 时不重试，相邻两个分片之间等待 delay。
+// 返回每个成功分片的响应以及每个设备的推送结果，只有全部设备都推送失败时才返回错误。
+// 厂商未返回单个设备的结果时，分片内的设备使用分片的推送结果。
+func MulticastSend(ctx context.Context, send BatchSendFunc, tokens []string, batchSize int, delay time.Duration, policy *RetryPolicy) ([]*Response, []push.TokenResult, error) {
+	// 分片的推送结果在分片结束后统一回调，send 内部不再回调
+	sendCtx := push.WithProgress(ctx, nil)
+
+	var resps []*Response
+	var results []push.TokenResult
+	for i, batch := range splitTokens(tokens, batchSize) {
+		if i > 0 && delay > 0 {
+			select {
+			case <-ctx.Done():
+				return resps, results, ctx.Err()
+			case <-time.After(delay):
+			}
 		}
-		var uniqueErrorStrings []string
-		for err := range uniqueErrors {
-			uniqueErrorStrings = append(uniqueErrorStrings, err)
+
+		res, attempts, err := policy.Do(sendCtx, func(ctx context.Context) (*Response, error) {
+			return send(ctx, batch)
+		})
+		if err == nil {
+			log.Printf("multicast send success: %d tokens", len(batch))
+			resps = append(resps, res)
+		} else {
+			log.Printf("multicast send error: %s (attempts %d)", err, attempts)
 		}
-		allErrorsString := strings.Join(uniqueErrorStrings, ", ")
-		return nil, errors.New(allErrorsString)
+		tokenResults := batchResults(batch, res, err, attempts)
+		push.ReportProgress(ctx, tokenResults...)
+		results = append(results, tokenResults...)
 	}
 
-	return resp, nil
+	return resps, results, resultsError(results)
+}
+
+// batchResults 生成一个分片内每个设备的推送结果
+func batchResults(batch []string, res *Response, err error, attempts int) []push.TokenResult {
+	if res != nil && len(res.Results) > 0 {
+		results := make([]push.TokenResult, len(res.Results))
+		for i, r := range res.Results {
+			if r.Attempts == 0 {
+				r.Attempts = attempts
+			}
+			results[i] = r
+		}
+		return results
+	}
+
+	results := make([]push.TokenResult, 0, len(batch))
+	for _, token := range batch {
+		r := push.TokenResult{Token: token, Success: err == nil, Attempts: attempts}
+		if res != nil {
+			r.Code = res.VendorCode
+			r.Msg = res.Msg
+			r.MessageID = res.MessageID
+			r.InvalidReason = res.InvalidReason
+		}
+		if err != nil {
+			r.Msg = err.Error()
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// splitTokens 将 tokens 按 size 切分成多个分片
+func splitTokens(tokens []string, size int) [][]string {
+	if size <= 0 {
+		size = len(tokens)
+	}
+	var batches [][]string
+	for start := 0; start < len(tokens); start += size {
+		end := start + size
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+		batches = append(batches, tokens[start:end])
+	}
+	return batches
+}
+
+func uniqueStrings(ss []string) []string {
+	seen := make(map[string]struct{}, len(ss))
+	var result []string
+	for _, s := range ss {
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		result = append(result, s)
+	}
+	return result
 }
@@ -0,0 +1,115 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/push"
+	"sync"
+	"testing"
+)
+
+func TestRetrySend(t *testing.T) {
+	send := func(ctx context.Context, token string) (*Response, error) {
+		if token == "bad" {
+			return &Response{Code: Fail, Msg: "invalid token", VendorCode: "400"}, errors.New("invalid token")
+		}
+		return &Response{Code: Success, Msg: "ok", MessageID: "msg-" + token}, nil
+	}
+
+	// Test partial success
+	resp, err := RetrySend(context.Background(), send, []string{"a", "bad", "b"}, nil, 10)
+	if err != nil {
+		t.Errorf("RetrySend partial success failed: expected nil error but got %v", err)
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("RetrySend failed: expected 3 results but got %d", len(resp.Results))
+	}
+	if !resp.Results[0].Success || resp.Results[0].MessageID != "msg-a" {
+		t.Errorf("RetrySend failed: unexpected result %+v", resp.Results[0])
+	}
+	if resp.Results[1].Success || resp.Results[1].Code != "400" || resp.Results[1].Attempts != 1 {
+		t.Errorf("RetrySend failed: unexpected result %+v", resp.Results[1])
+	}
+
+	// Test all failed
+	resp, err = RetrySend(context.Background(), send, []string{"bad"}, nil, 10)
+	if err == nil {
+		t.Errorf("RetrySend all failed: expected error but got nil")
+	}
+	if len(resp.Results) != 1 || resp.Results[0].Success {
+		t.Errorf("RetrySend all failed: unexpected results %+v", resp.Results)
+	}
+
+	// Test open circuit breaker is not retried
+	open := func(ctx context.Context, token string) (*Response, error) {
+		return nil, push.ErrCircuitOpen
+	}
+	resp, err = RetrySend(context.Background(), open, []string{"a"}, NewRetryPolicy(3, 1, nil), 10)
+	if err == nil || resp.Results[0].Attempts != 1 {
+		t.Errorf("RetrySend circuit open failed: expected 1 attempt but got %+v", resp.Results[0])
+	}
+}
+
+func TestMulticastSend(t *testing.T) {
+	var calls int
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		calls++
+		if tokens[0] == "bad" {
+			return &Response{Code: Fail, Msg: "invalid token"}, errors.New("invalid token")
+		}
+		return &Response{Code: Success, Msg: "ok", MessageID: "task"}, nil
+	}
+
+	resps, results, err := MulticastSend(context.Background(), send, []string{"a", "b", "bad", "c"}, 2, 0, nil)
+	if err != nil {
+		t.Errorf("MulticastSend partial success failed: expected nil error but got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("MulticastSend failed: expected 2 batches but got %d", calls)
+	}
+	if len(resps) != 1 {
+		t.Errorf("MulticastSend failed: expected 1 success response but got %d", len(resps))
+	}
+	if len(results) != 4 {
+		t.Fatalf("MulticastSend failed: expected 4 results but got %d", len(results))
+	}
+	if !results[0].Success || results[0].MessageID != "task" {
+		t.Errorf("MulticastSend failed: unexpected result %+v", results[0])
+	}
+	if results[2].Success || results[2].Token != "bad" {
+		t.Errorf("MulticastSend failed: unexpected result %+v", results[2])
+	}
+}
+
+func TestSendProgress(t *testing.T) {
+	var mu sync.Mutex
+	var reported []string
+	ctx := push.WithProgress(context.Background(), func(r push.TokenResult) {
+		mu.Lock()
+		reported = append(reported, r.Token)
+		mu.Unlock()
+	})
+
+	// Test RetrySend reports every token
+	send := func(ctx context.Context, token string) (*Response, error) {
+		return &Response{Code: Success, Msg: "ok"}, nil
+	}
+	if _, err := RetrySend(ctx, send, []string{"a", "b", "c"}, nil, 10); err != nil {
+		t.Errorf("RetrySend failed: %v", err)
+	}
+	if len(reported) != 3 {
+		t.Errorf("RetrySend progress failed: expected 3 reports but got %v", reported)
+	}
+
+	// Test MulticastSend reports every token once even if send uses RetrySend
+	reported = nil
+	batchSend := func(ctx context.Context, tokens []string) (*Response, error) {
+		return RetrySend(ctx, send, tokens, nil, 10)
+	}
+	if _, _, err := MulticastSend(ctx, batchSend, []string{"a", "b", "c"}, 2, 0, nil); err != nil {
+		t.Errorf("MulticastSend failed: %v", err)
+	}
+	if len(reported) != 3 {
+		t.Errorf("MulticastSend progress failed: expected 3 reports but got %v", reported)
+	}
+}
@@ -0,0 +1,165 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/push"
+	pushClient "github.com/cossim/hipush/pkg/client/push"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryInterval   = time.Second
+	defaultMaxInterval     = 30 * time.Second
+	defaultMaxElapsedTime  = 2 * time.Minute
+	defaultRetryMultiplier = 2
+	defaultRetryJitter     = 0.2
+)
+
+// RetryClassifier 判断一次推送失败是否可以重试，
+// 可以重试时返回厂商建议的等待时间（例如 Retry-After），没有建议时返回 0
+type RetryClassifier func(res *Response, err error) (retryable bool, retryAfter time.Duration)
+
+// RetryPolicy 推送失败时的重试策略，重试间隔按指数退避增长并加入随机抖动，
+// 只有 Classifier 判断为临时性的错误才会重试
+type RetryPolicy struct {
+	// MaxRetries 最大重试次数
+	MaxRetries int
+	// InitialInterval 第一次重试前的等待时间，之后每次乘以 Multiplier，最多为 MaxInterval
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter 等待时间的随机浮动比例，取值 [0, 1]
+	Jitter float64
+	// MaxElapsedTime 从第一次推送开始的最长重试时间，超过后不再重试，为 0 时不限制
+	MaxElapsedTime time.Duration
+	// Classifier 判断失败是否可以重试，为 nil 时使用 DefaultClassifier
+	Classifier RetryClassifier
+}
+
+// NewRetryPolicy 根据推送选项中的重试次数及重试间隔（以秒为单位）创建重试策略
+func NewRetryPolicy(retry, retryInterval int32, classifier RetryClassifier) *RetryPolicy {
+	interval := defaultRetryInterval
+	if retryInterval > 0 {
+		interval = time.Duration(retryInterval) * time.Second
+	}
+	maxInterval := defaultMaxInterval
+	if interval > maxInterval {
+		maxInterval = interval
+	}
+	return &RetryPolicy{
+		MaxRetries:      int(retry),
+		InitialInterval: interval,
+		MaxInterval:     maxInterval,
+		Multiplier:      defaultRetryMultiplier,
+		Jitter:          defaultRetryJitter,
+		MaxElapsedTime:  defaultMaxElapsedTime,
+		Classifier:      classifier,
+	}
+}
+
+// Do 调用 send 直到成功、遇到不可重试的错误、达到最大重试次数、超过最长重试时间或 ctx 结束，
+// 返回最后一次调用的结果以及调用次数。p 为 nil 时不重试
+func (p *RetryPolicy) Do(ctx context.Context, send func(ctx context.Context) (*Response, error)) (*Response, int, error) {
+	if p == nil {
+		p = &RetryPolicy{}
+	}
+	classifier := p.Classifier
+	if classifier == nil {
+		classifier = DefaultClassifier
+	}
+
+	start := time.Now()
+	interval := p.InitialInterval
+	for attempt := 1; ; attempt++ {
+		res, err := send(ctx)
+		if err == nil && (res == nil || res.Code != Success) {
+			err = errors.New(responseMsg(res))
+		}
+		if err == nil {
+			return res, attempt, nil
+		}
+		if attempt > p.MaxRetries {
+			return res, attempt, err
+		}
+		retryable, retryAfter := classifier(res, err)
+		if !retryable {
+			return res, attempt, err
+		}
+
+		wait := p.backoff(interval)
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+		if p.MaxElapsedTime > 0 && time.Since(start)+wait > p.MaxElapsedTime {
+			return res, attempt, err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res, attempt, err
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * p.Multiplier)
+		if p.MaxInterval > 0 && interval > p.MaxInterval {
+			interval = p.MaxInterval
+		}
+	}
+}
+
+// backoff 在 interval 的基础上加入随机抖动
+func (p *RetryPolicy) backoff(interval time.Duration) time.Duration {
+	if p.Jitter <= 0 || interval <= 0 {
+		return interval
+	}
+	delta := p.Jitter * float64(interval)
+	return time.Duration(float64(interval) - delta + rand.Float64()*2*delta)
+}
+
+// DefaultClassifier 只重试临时性的错误：网络错误及超时、HTTP 429 及 5xx。
+// 失效的 token、熔断、超出配额、应用未启用以及 ctx 取消都不会重试
+func DefaultClassifier(res *Response, err error) (bool, time.Duration) {
+	if res != nil && res.InvalidReason != "" {
+		return false, 0
+	}
+	if errors.Is(err, context.Canceled) ||
+		errors.Is(err, ErrInvalidAppID) ||
+		errors.Is(err, push.ErrCircuitOpen) ||
+		errors.Is(err, push.ErrQuotaExceeded) {
+		return false, 0
+	}
+	var he *pushClient.HTTPError
+	if errors.As(err, &he) {
+		return retryableStatus(he.StatusCode), he.RetryAfter
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true, 0
+	}
+	if err != nil && err.Error() == sdkNetworkError {
+		return true, 0
+	}
+	var ne net.Error
+	return errors.As(err, &ne), 0
+}
+
+// sdkNetworkError 小米、vivo、oppo 的 SDK 在 HTTP 状态码不为 200 时返回的错误信息，
+// SDK 没有返回具体的状态码，按服务端错误处理
+const sdkNetworkError = "network error"
+
+// retryableStatus 请求过多以及服务端错误可以重试
+func retryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+func responseMsg(res *Response) string {
+	if res == nil || res.Msg == "" {
+		return "push failed"
+	}
+	return res.Msg
+}
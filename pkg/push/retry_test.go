@@ -0,0 +1,89 @@
+package push
+
+import (
+	"context"
+	"errors"
+	pushClient "github.com/cossim/hipush/pkg/client/push"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := &RetryPolicy{MaxRetries: 3, InitialInterval: time.Millisecond, Multiplier: 2}
+
+	// Test transient error is retried
+	var calls int
+	res, attempts, err := policy.Do(context.Background(), func(ctx context.Context) (*Response, error) {
+		calls++
+		if calls < 3 {
+			return nil, &pushClient.HTTPError{StatusCode: http.StatusServiceUnavailable}
+		}
+		return &Response{Code: Success}, nil
+	})
+	if err != nil || res.Code != Success || attempts != 3 {
+		t.Errorf("Do transient error failed: expected success after 3 attempts but got %d attempts, err %v", attempts, err)
+	}
+
+	// Test permanent error and invalid token are not retried
+	_, attempts, err = policy.Do(context.Background(), func(ctx context.Context) (*Response, error) {
+		return nil, &pushClient.HTTPError{StatusCode: http.StatusBadRequest}
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("Do permanent error failed: expected 1 attempt but got %d", attempts)
+	}
+	_, attempts, err = policy.Do(context.Background(), func(ctx context.Context) (*Response, error) {
+		return &Response{Code: Fail, InvalidReason: "unregistered"}, errors.New("unregistered")
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("Do invalid token failed: expected 1 attempt but got %d", attempts)
+	}
+
+	// Test max retries
+	_, attempts, _ = policy.Do(context.Background(), func(ctx context.Context) (*Response, error) {
+		return nil, context.DeadlineExceeded
+	})
+	if attempts != 4 {
+		t.Errorf("Do max retries failed: expected 4 attempts but got %d", attempts)
+	}
+
+	// Test canceled context stops waiting
+	ctx, cancel := context.WithCancel(context.Background())
+	slow := &RetryPolicy{MaxRetries: 3, InitialInterval: time.Hour}
+	start := time.Now()
+	_, attempts, _ = slow.Do(ctx, func(ctx context.Context) (*Response, error) {
+		cancel()
+		return nil, &pushClient.HTTPError{StatusCode: http.StatusTooManyRequests}
+	})
+	if attempts != 1 || time.Since(start) > time.Second {
+		t.Errorf("Do canceled failed: expected 1 attempt without waiting but got %d in %s", attempts, time.Since(start))
+	}
+
+	// Test Retry-After longer than max elapsed time is not waited for
+	limited := &RetryPolicy{MaxRetries: 3, InitialInterval: time.Millisecond, MaxElapsedTime: time.Second}
+	_, attempts, _ = limited.Do(context.Background(), func(ctx context.Context) (*Response, error) {
+		return nil, &pushClient.HTTPError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Minute}
+	})
+	if attempts != 1 {
+		t.Errorf("Do retry after failed: expected 1 attempt but got %d", attempts)
+	}
+}
+
+func TestOppoRetryable(t *testing.T) {
+	o := &OppoService{}
+	tests := []struct {
+		code      string
+		retryable bool
+	}{
+		{"-1", true},
+		{"-2", true},
+		// Test an expired auth_token is retried after the SDK authenticates again
+		{"11", true},
+		{"10000", false},
+	}
+	for _, tt := range tests {
+		if retryable, _ := o.retryable(&Response{Code: Fail, VendorCode: tt.code}, errors.New("oppo error")); retryable != tt.retryable {
+			t.Errorf("retryable(%s) = %v, want %v", tt.code, retryable, tt.retryable)
+		}
+	}
+}
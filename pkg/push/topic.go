@@ -0,0 +1,77 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"github.com/cossim/hipush/api/push"
+)
+
+// unsupportedTopicManager 为不支持主题订阅的推送服务提供 push.TopicManager 的默认实现
+type unsupportedTopicManager struct{}
+
+func (unsupportedTopicManager) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return nil, push.ErrUnsupported
+}
+
+func (unsupportedTopicManager) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return nil, push.ErrUnsupported
+}
+
+func (unsupportedTopicManager) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	return nil, push.ErrUnsupported
+}
+
+func (unsupportedTopicManager) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	return nil, push.ErrUnsupported
+}
+
+// resolveAppID 根据 appID 或 appName 获取应用的 appid
+func resolveAppID(appID, appName string, appNameToIDMap map[string]string) (string, error) {
+	if appID != "" {
+		return appID, nil
+	}
+	if appName != "" {
+		if id, ok := appNameToIDMap[appName]; ok {
+			return id, nil
+		}
+	}
+	return "", ErrInvalidAppID
+}
+
+// checkTopicRequest 检查订阅或取消订阅主题的请求
+func checkTopicRequest(req push.TopicRequest) error {
+	if req.GetTopic() == "" {
+		return errors.New("topic cannot be empty")
+	}
+	if len(req.GetToken()) == 0 {
+		return errors.New("tokens cannot be empty")
+	}
+	return nil
+}
+
+// checkTopicMessage 检查发送到主题的消息
+func checkTopicMessage(topic string, req push.SendRequest) error {
+	if topic == "" {
+		return errors.New("topic cannot be empty")
+	}
+	if req.GetTitle() == "" {
+		return errors.New("title cannot be empty")
+	}
+	if req.GetContent() == "" {
+		return errors.New("content cannot be empty")
+	}
+	return nil
+}
+
+// topicResults 生成一组设备相同的订阅结果
+func topicResults(tokens []string, code, msg string, err error) []push.TokenResult {
+	results := make([]push.TokenResult, 0, len(tokens))
+	for _, token := range tokens {
+		r := push.TokenResult{Token: token, Success: err == nil, Code: code, Msg: msg, Attempts: 1}
+		if err != nil {
+			r.Msg = err.Error()
+		}
+		results = append(results, r)
+	}
+	return results
+}
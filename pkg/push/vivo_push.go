@@ -7,13 +7,20 @@ import (
 	"fmt"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
+	pushClient "github.com/cossim/hipush/pkg/client/push"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	vp "github.com/cossim/vivo-push"
 	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"log"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 var (
@@ -23,7 +30,9 @@ var (
 // VivoService 实现vivo推送，实现 PushService 接口
 type VivoService struct {
 	clients        map[string]*vp.VivoPush
+	tagClients     map[string]*pushClient.VivoTagClient
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
 	logger         logr.Logger
 }
@@ -31,7 +40,9 @@ type VivoService struct {
 func NewVivoService(cfg *config.Config, logger logr.Logger) *VivoService {
 	s := &VivoService{
 		clients:        make(map[string]*vp.VivoPush),
+		tagClients:     make(map[string]*pushClient.VivoTagClient),
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
 		logger:         logger,
 	}
@@ -48,6 +59,8 @@ func NewVivoService(cfg *config.Config, logger logr.Logger) *VivoService {
 			panic(err)
 		}
 		s.clients[v.AppID] = client
+		s.defaults[v.AppID] = v.SendConfig
+		s.tagClients[v.AppID] = pushClient.NewVivoTagClient(client.Auth_token)
 		if v.AppName != "" {
 			s.appNameToIDMap[v.AppName] = v.AppID
 		}
@@ -57,6 +70,12 @@ func NewVivoService(cfg *config.Config, logger logr.Logger) *VivoService {
 }
 
 func (v *VivoService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return v.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -72,6 +91,7 @@ func (v *VivoService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = v.defaults.request(appid, req)
 
 	notification, err := v.buildNotification(req, so)
 	if err != nil {
@@ -82,13 +102,20 @@ func (v *VivoService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(v.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(v.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return v.send(appid, token, notification)
 	}
 
-	resp, err := RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	resp, err := RetrySend(ctx, breakerSend(v.Name(), appid, limitSend(v.Name(), appid, send)), req.GetToken(), v.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, v.retryable), v.defaults.maxConcurrent(appid))
 	if err != nil {
-		return nil, err
+		return &push.SendResponse{Results: resp.Results}, err
 	}
 
 	taskid, err := v.getTaskIDFromResponse(resp)
@@ -96,7 +123,125 @@ func (v *VivoService) Send(ctx context.Context, req push.SendRequest, opt ...pus
 		return nil, err
 	}
 
-	return &push.SendResponse{TaskId: taskid}, nil
+	return &push.SendResponse{TaskId: taskid, Results: resp.Results}, nil
+}
+
+// vivoMaxMulticastTokens vivo 批量推送单次最多支持 1000 个 regId
+const vivoMaxMulticastTokens = 1000
+
+func (v *VivoService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return v.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = v.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = v.defaults.request(appid, req)
+
+	notification, err := v.buildNotification(req, &push.SendOptions{Development: mo.Development})
+	if err != nil {
+		return nil, err
+	}
+
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(v.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(v.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		return v.multicast(appid, tokens, notification)
+	}
+
+	resps, results, err := MulticastSend(ctx, breakerBatchSend(v.Name(), appid, limitBatchSend(v.Name(), appid, send)), req.GetToken(), mo.BatchSize(vivoMaxMulticastTokens), time.Duration(mo.Delay)*time.Millisecond, v.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, v.retryable))
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	resp := &push.SendResponse{Results: results}
+	for _, r := range resps {
+		if taskID, err := v.getTaskIDFromResponse(r); err == nil {
+			resp.TaskIDs = append(resp.TaskIDs, taskID)
+		}
+	}
+	if len(resp.TaskIDs) > 0 {
+		resp.TaskId = resp.TaskIDs[0]
+	}
+	return resp, nil
+}
+
+// multicast 通过 saveListPayload 保存消息体后调用 pushToList 批量推送，
+// vivo 批量推送要求至少 2 个 regId，单个 regId 时退化为单推
+func (v *VivoService) multicast(appid string, tokens []string, notification *vp.Message) (*Response, error) {
+	if len(tokens) == 1 {
+		return v.send(appid, tokens[0], notification)
+	}
+
+	client, ok := v.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	v.status.AddVivoTotal(int64(len(tokens)))
+
+	payload := &vp.MessagePayload{
+		Title:           notification.Title,
+		Content:         notification.Content,
+		NotifyType:      notification.NotifyType,
+		TimeToLive:      notification.TimeToLive,
+		SkipType:        notification.SkipType,
+		SkipContent:     notification.SkipContent,
+		NetworkType:     notification.NetworkType,
+		ClientCustomMap: notification.ClientCustomMap,
+		// 每个分片都会保存一次消息体，requestId 需要保持唯一，否则会被 vivo 去重
+		RequestId: uuid.New().String(),
+	}
+
+	resp := &Response{Code: Fail}
+	res, err := client.SendList(payload, tokens)
+	if res != nil {
+		resp.VendorCode = strconv.Itoa(res.Result)
+		resp.MessageID = res.TaskId
+	}
+	if err != nil {
+		log.Printf("vivo multicast error: %s", err)
+		v.status.AddVivoFailed(int64(len(tokens)))
+		resp.Msg = err.Error()
+	} else {
+		log.Printf("vivo multicast success taskId: %v", res.TaskId)
+		v.status.AddVivoSuccess(int64(len(tokens)))
+		resp.Code = Success
+		resp.Msg = res.Desc
+		resp.Data = res
+	}
+
+	return resp, err
+}
+
+// retryable vivo 只重试临时性的错误。vivo-push 缓存 authToken 1 小时后重新鉴权，
+// 而 vivo 的 authToken 有效期为 1 天，缓存的 authToken 不会过期，因此不处理鉴权失败的返回码
+func (v *VivoService) retryable(res *Response, err error) (bool, time.Duration) {
+	return DefaultClassifier(res, err)
 }
 
 // getTaskIDFromResponse 从 Response 结构体中获取 task_id 字段
@@ -119,14 +264,20 @@ func (v *VivoService) getTaskIDFromResponse(response *Response) (string, error)
 func (v *VivoService) send(appid string, token string, notification *vp.Message) (*Response, error) {
 	client, ok := v.clients[appid]
 	if !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 
 	v.status.AddVivoTotal(1)
 
 	resp := &Response{Code: Fail}
-	notification.RegId = token
-	res, err := client.Send(notification, token)
+	// 并发推送时共用同一个 notification，复制后再设置设备 token
+	n := *notification
+	n.RegId = token
+	res, err := client.Send(&n, token)
+	if res != nil {
+		resp.VendorCode = strconv.Itoa(res.Result)
+		resp.MessageID = res.TaskId
+	}
 	if err != nil {
 		log.Printf("vivo send error: %s", err)
 		v.status.AddVivoFailed(1)
@@ -269,6 +420,172 @@ func (v *VivoService) GetTasksStatus(ctx context.Context, key string, tasks []st
 	return err
 }
 
+// vivoMaxTagMembers vivo 单次添加或删除标签成员最多支持 100 个 regId
+const vivoMaxTagMembers = 100
+
+// Subscribe vivo 使用标签实现主题订阅，标签不存在时自动创建
+func (v *VivoService) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return v.manageTag(ctx, req, true)
+}
+
+func (v *VivoService) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return v.manageTag(ctx, req, false)
+}
+
+func (v *VivoService) manageTag(ctx context.Context, req push.TopicRequest, add bool) (*push.TopicResponse, error) {
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), v.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkTopicRequest(req); err != nil {
+		return nil, err
+	}
+
+	client, ok := v.tagClients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	if add {
+		// 标签已存在时会返回错误，忽略即可
+		if _, err := client.AddTag(ctx, req.GetTopic()); err != nil {
+			log.Printf("vivo add tag error: %s", err)
+		}
+	}
+
+	resp := &push.TopicResponse{}
+	for _, batch := range splitTokens(req.GetToken(), vivoMaxTagMembers) {
+		members := &pushClient.VivoTagMembersRequest{Name: req.GetTopic(), Type: pushClient.VivoTagMemberType, Ids: batch}
+		var res *pushClient.VivoTagResponse
+		if add {
+			res, err = client.AddMembers(ctx, members)
+		} else {
+			res, err = client.RemoveMembers(ctx, members)
+		}
+		if err == nil && res.Result != 0 {
+			err = errors.New(res.Desc)
+		}
+		if err != nil {
+			log.Printf("vivo tag error: %s", err)
+		}
+		var code string
+		if res != nil {
+			code = strconv.Itoa(res.Result)
+		}
+		resp.Results = append(resp.Results, topicResults(batch, code, "", err)...)
+	}
+
+	return resp, resultsError(resp.Results)
+}
+
+// SendToTopic 按标签推送
+func (v *VivoService) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	to := &push.TopicOptions{}
+	to.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), v.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+	req = v.defaults.request(appid, req)
+
+	if err := checkTopicMessage(topic, req); err != nil {
+		return nil, err
+	}
+
+	client, ok := v.tagClients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	notifyType := int(req.GetNotifyType())
+	if notifyType == 0 {
+		notifyType = 2
+	}
+	var pushMode int
+	if to.Development {
+		pushMode = 1
+	}
+	data := make(map[string]string)
+	for key, value := range req.GetCustomData() {
+		data[key] = fmt.Sprintf("%v", value)
+	}
+	message := &pushClient.VivoTagPushRequest{
+		NotifyType:      notifyType,
+		Title:           req.GetTitle(),
+		Content:         req.GetContent(),
+		TimeToLive:      req.GetTTL(),
+		SkipType:        1,
+		NetworkType:     -1,
+		ClientCustomMap: data,
+		PushMode:        pushMode,
+		TagExpression:   &pushClient.VivoTagExpression{OrTags: []string{topic}},
+	}
+	if req.GetClickAction() != nil {
+		message.SkipContent = req.GetClickAction().Url
+	}
+
+	if to.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(v.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(v.Name(), appid, 1); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, _ string) (*Response, error) {
+		// requestId 用于 vivo 去重，每次重试都需要重新生成
+		message.RequestId = uuid.New().String()
+		resp := &Response{Code: Fail}
+		res, err := client.TagPush(ctx, message)
+		if res != nil {
+			resp.VendorCode = strconv.Itoa(res.Result)
+			resp.MessageID = res.TaskId
+		}
+		if err == nil && res.Result != 0 {
+			err = errors.New(res.Desc)
+		}
+		if err != nil {
+			log.Printf("vivo tag push error: %s", err)
+			resp.Msg = err.Error()
+			return resp, err
+		}
+		log.Printf("vivo tag push success taskId: %v", res.TaskId)
+		resp.Code = Success
+		resp.Msg = res.Desc
+		return resp, nil
+	}
+
+	resp, err := RetrySend(ctx, breakerSend(v.Name(), appid, limitSend(v.Name(), appid, send)), []string{topic}, v.defaults.retryPolicy(appid, to.Retry, to.RetryInterval, v.retryable), 1)
+	if err != nil {
+		return &push.SendResponse{Results: resp.Results}, err
+	}
+
+	return &push.SendResponse{TaskId: resp.Results[0].MessageID, Results: resp.Results}, nil
+}
+
+// ListTopics vivo 不支持查询设备所属的标签
+func (v *VivoService) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	return nil, push.ErrUnsupported
+}
+
+// CheckDevice vivo 没有只校验不推送的接口，只检查 token 格式以及已记录的失效 token
+func (v *VivoService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), v.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkDevices(ctx, v.Name(), appid, req.GetToken(), vivoTokenFormat, feedback.InvalidTokenStorage, nil, co)
+}
+
 func (v *VivoService) Name() string {
 	return consts.PlatformVivo.String()
 }
@@ -7,12 +7,17 @@ import (
 	"fmt"
 	"github.com/cossim/hipush/api/push"
 	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/breaker"
 	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/feedback"
+	"github.com/cossim/hipush/pkg/ratelimit"
 	"github.com/cossim/hipush/pkg/status"
 	xp "github.com/cossim/xiaomi-push"
 	"github.com/go-logr/logr"
 	"log"
+	"strconv"
 	"strings"
+	"time"
 )
 
 var (
@@ -23,6 +28,7 @@ var (
 type XiaomiPushService struct {
 	clients        map[string]*xp.MiPush
 	appNameToIDMap map[string]string
+	defaults       sendDefaults
 	status         *status.StateStorage
 	logger         logr.Logger
 }
@@ -31,6 +37,7 @@ func NewXiaomiService(cfg *config.Config, logger logr.Logger) *XiaomiPushService
 	s := &XiaomiPushService{
 		clients:        make(map[string]*xp.MiPush),
 		appNameToIDMap: make(map[string]string),
+		defaults:       make(sendDefaults),
 		status:         status.StatStorage,
 		logger:         logger,
 	}
@@ -42,8 +49,10 @@ func NewXiaomiService(cfg *config.Config, logger logr.Logger) *XiaomiPushService
 		client := xp.NewClient(v.AppSecret, v.Package)
 		if v.AppID == "" {
 			s.clients[v.AppName] = client
+			s.defaults[v.AppName] = v.SendConfig
 		} else {
 			s.clients[v.AppID] = client
+			s.defaults[v.AppID] = v.SendConfig
 		}
 
 		if v.AppName != "" {
@@ -59,6 +68,12 @@ func NewXiaomiService(cfg *config.Config, logger logr.Logger) *XiaomiPushService
 }
 
 func (x *XiaomiPushService) Send(ctx context.Context, req push.SendRequest, opt ...push.SendOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return x.Send(ctx, req, opt...)
+		})
+	}
+
 	so := &push.SendOptions{}
 	so.ApplyOptions(opt)
 
@@ -74,6 +89,7 @@ func (x *XiaomiPushService) Send(ctx context.Context, req push.SendRequest, opt
 	} else {
 		return nil, ErrInvalidAppID
 	}
+	req = x.defaults.request(appid, req)
 
 	if err := x.checkNotification(req); err != nil {
 		return nil, err
@@ -88,13 +104,20 @@ func (x *XiaomiPushService) Send(ctx context.Context, req push.SendRequest, opt
 		return nil, nil
 	}
 
+	if err := breaker.PushBreakers.Check(x.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(x.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
 	send := func(ctx context.Context, token string) (*Response, error) {
 		return x.send(ctx, appid, token, notification)
 	}
 
-	res, err := RetrySend(ctx, send, req.GetToken(), so.Retry, so.RetryInterval, 100)
+	res, err := RetrySend(ctx, breakerSend(x.Name(), appid, limitSend(x.Name(), appid, send)), req.GetToken(), x.defaults.retryPolicy(appid, so.Retry, so.RetryInterval, DefaultClassifier), x.defaults.maxConcurrent(appid))
 	if err != nil {
-		return nil, err
+		return &push.SendResponse{Results: res.Results}, err
 	}
 
 	taskid, err := x.getTaskIDFromResponse(res)
@@ -102,7 +125,110 @@ func (x *XiaomiPushService) Send(ctx context.Context, req push.SendRequest, opt
 		return nil, err
 	}
 
-	return &push.SendResponse{TaskId: taskid}, nil
+	return &push.SendResponse{TaskId: taskid, Results: res.Results}, nil
+}
+
+// xiaomiMaxMulticastTokens 小米单次推送最多支持 1000 个 regId
+const xiaomiMaxMulticastTokens = 1000
+
+func (x *XiaomiPushService) Multicast(ctx context.Context, req push.SendRequest, opt ...push.MulticastOption) (*push.SendResponse, error) {
+	if reqs := localize(req); reqs != nil {
+		return sendLocalized(ctx, req.GetToken(), reqs, func(ctx context.Context, req push.SendRequest) (*push.SendResponse, error) {
+			return x.Multicast(ctx, req, opt...)
+		})
+	}
+
+	mo := &push.MulticastOptions{}
+	mo.ApplyOptions(opt)
+
+	var appid string
+	var ok bool
+	if req.GetAppID() != "" {
+		appid = req.GetAppID()
+	} else if req.GetAppName() != "" {
+		appid, ok = x.appNameToIDMap[req.GetAppName()]
+		if !ok {
+			return nil, ErrInvalidAppID
+		}
+	} else {
+		return nil, ErrInvalidAppID
+	}
+	req = x.defaults.request(appid, req)
+
+	if err := x.checkNotification(req); err != nil {
+		return nil, err
+	}
+
+	notification, err := x.buildNotification(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if mo.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(x.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(x.Name(), appid, len(req.GetToken())); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, tokens []string) (*Response, error) {
+		// 小米使用逗号分隔的 regId 列表进行批量推送
+		return x.multicast(ctx, appid, tokens, notification)
+	}
+
+	resps, results, err := MulticastSend(ctx, breakerBatchSend(x.Name(), appid, limitBatchSend(x.Name(), appid, send)), req.GetToken(), mo.BatchSize(xiaomiMaxMulticastTokens), time.Duration(mo.Delay)*time.Millisecond, x.defaults.retryPolicy(appid, mo.Retry, mo.RetryInterval, DefaultClassifier))
+	if err != nil {
+		return &push.SendResponse{Results: results}, err
+	}
+
+	resp := &push.SendResponse{Results: results}
+	for _, r := range resps {
+		if taskID, err := x.getTaskIDFromResponse(r); err == nil {
+			resp.TaskIDs = append(resp.TaskIDs, taskID)
+		}
+	}
+	if len(resp.TaskIDs) > 0 {
+		resp.TaskId = resp.TaskIDs[0]
+	}
+	return resp, nil
+}
+
+func (x *XiaomiPushService) multicast(ctx context.Context, appID string, tokens []string, message *xp.Message) (*Response, error) {
+	client, ok := x.clients[appID]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	x.status.AddXiaomiTotal(int64(len(tokens)))
+
+	resp := &Response{Code: Fail}
+	res, err := client.SendToList(ctx, message, tokens)
+	if res != nil {
+		resp.VendorCode = strconv.FormatInt(res.Code, 10)
+		resp.MessageID = res.Data.ID
+	}
+	if err != nil {
+		log.Printf("xiaomi multicast error: %s", err)
+		x.status.AddXiaomiFailed(int64(len(tokens)))
+		resp.Msg = err.Error()
+	} else if res.Code != 0 {
+		log.Printf("xiaomi multicast error: %s", res.Reason)
+		x.status.AddXiaomiFailed(int64(len(tokens)))
+		err = errors.New(res.Reason)
+		resp.Code = int(res.Code)
+		resp.Msg = res.Reason
+	} else {
+		log.Printf("xiaomi multicast success: %v", res)
+		x.status.AddXiaomiSuccess(int64(len(tokens)))
+		resp.Code = Success
+		resp.Msg = res.Reason
+		resp.Data = res
+	}
+	return resp, err
 }
 
 // getTaskIDFromResponse 从 Response 结构体中获取 task_id 字段
@@ -214,13 +340,17 @@ func (x *XiaomiPushService) buildNotification(req push.SendRequest) (*xp.Message
 func (x *XiaomiPushService) send(ctx context.Context, appID string, token string, message *xp.Message) (*Response, error) {
 	client, ok := x.clients[appID]
 	if !ok {
-		return nil, errors.New("invalid appid or appid push is not enabled")
+		return nil, ErrInvalidAppID
 	}
 
 	x.status.AddXiaomiTotal(1)
 
 	resp := &Response{Code: Fail}
 	res, err := client.Send(ctx, message, token)
+	if res != nil {
+		resp.VendorCode = strconv.FormatInt(res.Code, 10)
+		resp.MessageID = res.Data.ID
+	}
 	if err != nil {
 		log.Printf("xiaomi send error: %s", err)
 		x.status.AddXiaomiFailed(1)
@@ -241,6 +371,150 @@ func (x *XiaomiPushService) send(ctx context.Context, appID string, token string
 	return resp, err
 }
 
+func (x *XiaomiPushService) Subscribe(ctx context.Context, req push.TopicRequest, opt ...push.SubscribeOption) (*push.TopicResponse, error) {
+	return x.manageTopic(ctx, req, (*xp.MiPush).SubscribeTopicForRegIDList)
+}
+
+func (x *XiaomiPushService) Unsubscribe(ctx context.Context, req push.TopicRequest, opt ...push.UnsubscribeOption) (*push.TopicResponse, error) {
+	return x.manageTopic(ctx, req, (*xp.MiPush).UnSubscribeTopicForRegIDList)
+}
+
+func (x *XiaomiPushService) manageTopic(ctx context.Context, req push.TopicRequest, fn func(client *xp.MiPush, ctx context.Context, regIDList []string, topic, category string) (*xp.Result, error)) (*push.TopicResponse, error) {
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), x.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkTopicRequest(req); err != nil {
+		return nil, err
+	}
+
+	client, ok := x.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	resp := &push.TopicResponse{}
+	for _, batch := range splitTokens(req.GetToken(), xiaomiMaxMulticastTokens) {
+		res, err := fn(client, ctx, batch, req.GetTopic(), "")
+		if err == nil && res.Code != 0 {
+			err = errors.New(res.Reason)
+		}
+		if err != nil {
+			log.Printf("xiaomi topic error: %s", err)
+		}
+		var code string
+		if res != nil {
+			code = strconv.FormatInt(res.Code, 10)
+		}
+		resp.Results = append(resp.Results, topicResults(batch, code, "", err)...)
+	}
+
+	return resp, resultsError(resp.Results)
+}
+
+func (x *XiaomiPushService) SendToTopic(ctx context.Context, topic string, req push.SendRequest, opt ...push.TopicOption) (*push.SendResponse, error) {
+	to := &push.TopicOptions{}
+	to.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), x.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+	req = x.defaults.request(appid, req)
+
+	if err := checkTopicMessage(topic, req); err != nil {
+		return nil, err
+	}
+
+	client, ok := x.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	notification, err := x.buildNotification(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if to.DryRun {
+		return nil, nil
+	}
+
+	if err := breaker.PushBreakers.Check(x.Name(), appid); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.PushLimiter.Reserve(x.Name(), appid, 1); err != nil {
+		return nil, err
+	}
+
+	send := func(ctx context.Context, _ string) (*Response, error) {
+		resp := &Response{Code: Fail}
+		res, err := client.Broadcast(ctx, notification, topic)
+		if res != nil {
+			resp.VendorCode = strconv.FormatInt(res.Code, 10)
+			resp.MessageID = res.Data.ID
+		}
+		if err == nil && res.Code != 0 {
+			err = errors.New(res.Reason)
+		}
+		if err != nil {
+			log.Printf("xiaomi topic send error: %s", err)
+			resp.Msg = err.Error()
+			return resp, err
+		}
+		log.Printf("xiaomi topic send success: %v", res)
+		resp.Code = Success
+		resp.Msg = res.Reason
+		return resp, nil
+	}
+
+	resp, err := RetrySend(ctx, breakerSend(x.Name(), appid, limitSend(x.Name(), appid, send)), []string{topic}, x.defaults.retryPolicy(appid, to.Retry, to.RetryInterval, DefaultClassifier), 1)
+	if err != nil {
+		return &push.SendResponse{Results: resp.Results}, err
+	}
+
+	return &push.SendResponse{TaskId: resp.Results[0].MessageID, Results: resp.Results}, nil
+}
+
+func (x *XiaomiPushService) ListTopics(ctx context.Context, req push.ListTopicsRequest) ([]string, error) {
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), x.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetToken() == "" {
+		return nil, errors.New("token cannot be empty")
+	}
+
+	client, ok := x.clients[appid]
+	if !ok {
+		return nil, ErrInvalidAppID
+	}
+
+	res, err := client.GetTopicsOfRegID(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, errors.New(res.Reason)
+	}
+	return res.Data.List, nil
+}
+
+// CheckDevice 小米没有只校验不推送的接口，只检查 token 格式以及已记录的失效 token
+func (x *XiaomiPushService) CheckDevice(ctx context.Context, req push.CheckDeviceRequest, opt ...push.CheckDeviceOption) (*push.CheckDeviceResponse, error) {
+	co := &push.CheckDeviceOptions{}
+	co.ApplyOptions(opt)
+
+	appid, err := resolveAppID(req.GetAppID(), req.GetAppName(), x.appNameToIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkDevices(ctx, x.Name(), appid, req.GetToken(), xiaomiTokenFormat, feedback.InvalidTokenStorage, nil, co)
+}
+
 func (x *XiaomiPushService) Name() string {
 	return consts.PlatformXiaomi.String()
 }
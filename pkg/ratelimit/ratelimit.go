@@ -0,0 +1,303 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/consts"
+	"github.com/cossim/hipush/pkg/store"
+	"golang.org/x/time/rate"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PushLimiter 推送限流器，未初始化时不做任何限制
+var PushLimiter *Limiter
+
+const dayLayout = "2006-01-02"
+
+func InitPushLimiter(cfg *config.Config) error {
+	s, err := store.NewObjectStore(cfg.Storage.Type, cfg.Storage.Path, "quota_usage")
+	if err != nil {
+		return err
+	}
+
+	PushLimiter = NewLimiter(s)
+	for platform, c := range cfg.RateLimit {
+		PushLimiter.SetLimit(platform, "", c)
+	}
+	for _, app := range cfg.IOS {
+		PushLimiter.SetLimit(consts.PlatformIOS.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	for _, app := range cfg.Android {
+		PushLimiter.SetLimit(consts.PlatformAndroid.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	for _, app := range cfg.Huawei {
+		PushLimiter.SetLimit(consts.PlatformHuawei.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	for _, app := range cfg.Xiaomi {
+		PushLimiter.SetLimit(consts.PlatformXiaomi.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	for _, app := range cfg.Vivo {
+		PushLimiter.SetLimit(consts.PlatformVivo.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	for _, app := range cfg.Oppo {
+		PushLimiter.SetLimit(consts.PlatformOppo.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	for _, app := range cfg.Meizu {
+		PushLimiter.SetLimit(consts.PlatformMeizu.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	for _, app := range cfg.Honor {
+		PushLimiter.SetLimit(consts.PlatformHonor.String(), appKey(app.AppID, app.AppName), app.RateLimit)
+	}
+	return PushLimiter.Init()
+}
+
+// Usage 平台或应用的限流配置及当天的配额使用情况
+type Usage struct {
+	Platform string `json:"platform"`
+	// AppID 为空时表示平台级别的限制
+	AppID      string  `json:"app_id,omitempty"`
+	QPS        float64 `json:"qps"`
+	Burst      int     `json:"burst"`
+	DailyQuota int64   `json:"daily_quota"`
+	Used       int64   `json:"used"`
+	// Remaining 当天剩余的配额，未限制每日配额时为 -1
+	Remaining int64 `json:"remaining"`
+}
+
+type bucket struct {
+	platform string
+	appID    string
+	cfg      config.RateLimitConfig
+	limiter  *rate.Limiter
+	day      string
+	used     int64
+}
+
+// Limiter 按平台及应用限制调用厂商接口的 QPS（令牌桶）以及每日推送的设备数量，
+// 平台级别与应用级别的限制同时生效，当天的配额使用量保存在 store 中
+type Limiter struct {
+	store   store.ObjectStore
+	mutex   sync.Mutex
+	buckets map[string]*bucket
+}
+
+func NewLimiter(store store.ObjectStore) *Limiter {
+	return &Limiter{
+		store:   store,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (l *Limiter) Init() error {
+	if err := l.store.Init(); err != nil {
+		return err
+	}
+
+	// 删除之前的配额使用量
+	today := time.Now().Format(dayLayout)
+	var expired []string
+	l.store.Range("", func(key string, _ []byte) bool {
+		if !strings.HasPrefix(key, today+"/") {
+			expired = append(expired, key)
+		}
+		return true
+	})
+	for _, key := range expired {
+		l.store.Del(key)
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for key, b := range l.buckets {
+		value, ok := l.store.Get(today + "/" + key)
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(value, &b.used); err != nil {
+			log.Printf("failed to load quota usage of %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.store.Close()
+}
+
+// SetLimit 设置平台（appID 为空）或应用的限制，未配置任何限制时忽略
+func (l *Limiter) SetLimit(platform, appID string, cfg config.RateLimitConfig) {
+	if cfg.QPS <= 0 && cfg.DailyQuota <= 0 {
+		return
+	}
+
+	b := &bucket{platform: platform, appID: appID, cfg: cfg, day: time.Now().Format(dayLayout)}
+	if cfg.QPS > 0 {
+		burst := cfg.Burst
+		if burst <= 0 {
+			burst = int(cfg.QPS)
+			if burst < 1 {
+				burst = 1
+			}
+		}
+		b.cfg.Burst = burst
+		b.limiter = rate.NewLimiter(rate.Limit(cfg.QPS), burst)
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.buckets[bucketKey(platform, appID)] = b
+}
+
+// Reserve 在推送前占用 n 个设备的每日配额，平台或应用的剩余配额不足时不占用任何配额，
+// 返回 push.ErrQuotaExceeded
+func (l *Limiter) Reserve(platform, appID string, n int) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	today := time.Now().Format(dayLayout)
+	buckets := l.lookup(platform, appID)
+	for _, b := range buckets {
+		if b.day != today {
+			b.day = today
+			b.used = 0
+		}
+		if b.cfg.DailyQuota > 0 && b.used+int64(n) > b.cfg.DailyQuota {
+			return fmt.Errorf("%w: %s used %d of %d", push.ErrQuotaExceeded, b.name(), b.used, b.cfg.DailyQuota)
+		}
+	}
+	for _, b := range buckets {
+		if b.cfg.DailyQuota <= 0 {
+			continue
+		}
+		b.used += int64(n)
+		data, _ := json.Marshal(b.used)
+		if err := l.store.Set(today+"/"+bucketKey(b.platform, b.appID), data); err != nil {
+			log.Printf("failed to save quota usage of %s: %v", b.name(), err)
+		}
+	}
+	return nil
+}
+
+// Wait 在调用厂商接口前等待平台及应用的令牌桶，ctx 结束时返回错误
+func (l *Limiter) Wait(ctx context.Context, platform, appID string) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	buckets := l.lookup(platform, appID)
+	l.mutex.Unlock()
+
+	for _, b := range buckets {
+		if b.limiter == nil {
+			continue
+		}
+		if err := b.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QPS 返回平台及应用调用厂商接口的 QPS 限制，平台与应用都有限制时返回较小的限制，未限制时返回 0
+func (l *Limiter) QPS(platform, appID string) float64 {
+	if l == nil {
+		return 0
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	var qps float64
+	for _, b := range l.lookup(platform, appID) {
+		if b.limiter != nil && (qps == 0 || b.cfg.QPS < qps) {
+			qps = b.cfg.QPS
+		}
+	}
+	return qps
+}
+
+// Usage 返回所有配置了限制的平台及应用当天的配额使用情况
+func (l *Limiter) Usage() []Usage {
+	if l == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	today := time.Now().Format(dayLayout)
+	usages := make([]Usage, 0, len(l.buckets))
+	for _, b := range l.buckets {
+		u := Usage{
+			Platform:   b.platform,
+			AppID:      b.appID,
+			QPS:        b.cfg.QPS,
+			Burst:      b.cfg.Burst,
+			DailyQuota: b.cfg.DailyQuota,
+			Remaining:  -1,
+		}
+		if b.day == today {
+			u.Used = b.used
+		}
+		if b.cfg.DailyQuota > 0 {
+			u.Remaining = b.cfg.DailyQuota - u.Used
+		}
+		usages = append(usages, u)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Platform != usages[j].Platform {
+			return usages[i].Platform < usages[j].Platform
+		}
+		return usages[i].AppID < usages[j].AppID
+	})
+	return usages
+}
+
+// lookup 返回平台及应用的限制，调用方需持有锁
+func (l *Limiter) lookup(platform, appID string) []*bucket {
+	var buckets []*bucket
+	if b, ok := l.buckets[bucketKey(platform, "")]; ok {
+		buckets = append(buckets, b)
+	}
+	if appID != "" {
+		if b, ok := l.buckets[bucketKey(platform, appID)]; ok {
+			buckets = append(buckets, b)
+		}
+	}
+	return buckets
+}
+
+func (b *bucket) name() string {
+	if b.appID == "" {
+		return "platform " + b.platform
+	}
+	return "app " + b.appID
+}
+
+// appKey 应用级别限制的 key，与推送服务调用 Reserve 及 Wait 时传入的 appID 一致，
+// 应用只配置了 app_name 时推送服务使用 app_name
+func appKey(appID, appName string) string {
+	if appID == "" {
+		return appName
+	}
+	return appID
+}
+
+func bucketKey(platform, appID string) string {
+	return strings.TrimSuffix(platform+"/"+appID, "/")
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"errors"
+	"github.com/cossim/hipush/api/push"
+	"github.com/cossim/hipush/config"
+	"github.com/cossim/hipush/pkg/store"
+	"testing"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	s, err := store.NewObjectStore("memory", "", "quota_usage")
+	if err != nil {
+		t.Fatalf("NewObjectStore failed: %v", err)
+	}
+	l := NewLimiter(s)
+	l.SetLimit("ios", "", config.RateLimitConfig{DailyQuota: 10})
+	l.SetLimit("ios", "app1", config.RateLimitConfig{QPS: 5, DailyQuota: 5})
+	if err := l.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// Test app quota
+	if err := l.Reserve("ios", "app1", 3); err != nil {
+		t.Errorf("Reserve failed: %v", err)
+	}
+	if err := l.Reserve("ios", "app1", 3); !errors.Is(err, push.ErrQuotaExceeded) {
+		t.Errorf("Reserve failed: expected ErrQuotaExceeded but got %v", err)
+	}
+
+	// Test platform quota is shared by apps and a rejected request reserves nothing
+	if err := l.Reserve("ios", "app2", 7); err != nil {
+		t.Errorf("Reserve failed: %v", err)
+	}
+	if err := l.Reserve("ios", "app1", 1); !errors.Is(err, push.ErrQuotaExceeded) {
+		t.Errorf("Reserve failed: expected ErrQuotaExceeded but got %v", err)
+	}
+
+	// Test unlimited platform
+	if err := l.Reserve("android", "app1", 1000); err != nil {
+		t.Errorf("Reserve failed: %v", err)
+	}
+
+	usages := l.Usage()
+	if len(usages) != 2 {
+		t.Fatalf("Usage failed: expected 2 usages but got %d", len(usages))
+	}
+	if u := usages[1]; u.AppID != "app1" || u.Used != 3 || u.Remaining != 2 || u.Burst != 5 {
+		t.Errorf("Usage failed: unexpected app usage %+v", u)
+	}
+	if u := usages[0]; u.AppID != "" || u.Used != 10 || u.Remaining != 0 {
+		t.Errorf("Usage failed: unexpected platform usage %+v", u)
+	}
+
+	// Test nil limiter
+	var nl *Limiter
+	if err := nl.Reserve("ios", "app1", 1); err != nil {
+		t.Errorf("Reserve failed: expected nil limiter to allow but got %v", err)
+	}
+}
+
+func TestInitPushLimiterAppName(t *testing.T) {
+	cfg := &config.Config{
+		Storage: config.Storage{Type: "memory"},
+		Android: []config.AndroidAppConfig{
+			{AppName: "named", RateLimit: config.RateLimitConfig{DailyQuota: 2}},
+			{AppName: "other", AppID: "app1", RateLimit: config.RateLimitConfig{DailyQuota: 1}},
+		},
+	}
+	if err := InitPushLimiter(cfg); err != nil {
+		t.Fatalf("InitPushLimiter failed: %v", err)
+	}
+	defer func() {
+		PushLimiter.Close()
+		PushLimiter = nil
+	}()
+
+	// Test app configured by name only is limited under its name
+	if err := PushLimiter.Reserve("android", "named", 3); !errors.Is(err, push.ErrQuotaExceeded) {
+		t.Errorf("Reserve failed: expected ErrQuotaExceeded but got %v", err)
+	}
+
+	// Test app with id is limited under its id
+	if err := PushLimiter.Reserve("android", "app1", 2); !errors.Is(err, push.ErrQuotaExceeded) {
+		t.Errorf("Reserve failed: expected ErrQuotaExceeded but got %v", err)
+	}
+	if err := PushLimiter.Reserve("android", "other", 2); err != nil {
+		t.Errorf("Reserve failed: expected app name of app with id to be unlimited but got %v", err)
+	}
+}
@@ -0,0 +1,240 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ObjectStore 存储结构化数据，value 为 json 编码后的数据
+type ObjectStore interface {
+	Init() error
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte) error
+	Del(key string) error
+	// Range 按 key 的字典序遍历所有以 prefix 开头的数据，fn 返回 false 时停止遍历
+	Range(prefix string, fn func(key string, value []byte) bool)
+	Close() error
+}
+
+// NewObjectStore 根据存储类型创建 ObjectStore，
+// file 类型的数据保存在 path 所在目录下的 name.json 文件中
+func NewObjectStore(typ, path, name string) (ObjectStore, error) {
+	switch typ {
+	case "memory":
+		return NewMemoryObjectStore(), nil
+	case "file":
+		if path == "" {
+			path = defaultPath
+		}
+		return NewFileObjectStore(filepath.Join(filepath.Dir(path), name+".json")), nil
+	default:
+		return nil, errors.New("can't find storage driver")
+	}
+}
+
+type MemoryObjectStore struct {
+	mutex sync.RWMutex
+	data  map[string][]byte
+}
+
+func NewMemoryObjectStore() *MemoryObjectStore {
+	return &MemoryObjectStore{
+		data: make(map[string][]byte),
+	}
+}
+
+func (m *MemoryObjectStore) Init() error {
+	return nil
+}
+
+func (m *MemoryObjectStore) Get(key string) ([]byte, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	value, ok := m.data[key]
+	return value, ok
+}
+
+func (m *MemoryObjectStore) Set(key string, value []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.data[key] = value
+	return nil
+}
+
+func (m *MemoryObjectStore) Del(key string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.data, key)
+	return nil
+}
+
+func (m *MemoryObjectStore) Range(prefix string, fn func(key string, value []byte) bool) {
+	m.mutex.RLock()
+	keys := make([]string, 0, len(m.data))
+	for key := range m.data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		values[i] = m.data[key]
+	}
+	m.mutex.RUnlock()
+
+	for i, key := range keys {
+		if !fn(key, values[i]) {
+			return
+		}
+	}
+}
+
+func (m *MemoryObjectStore) Close() error {
+	return nil
+}
+
+// FileObjectStore 在内存中保存数据，并定时将修改后的数据写入文件
+type FileObjectStore struct {
+	*MemoryObjectStore
+	path       string
+	dirty      bool
+	dirtyMutex sync.Mutex
+	saveTicker *time.Ticker
+	done       chan struct{}
+}
+
+func NewFileObjectStore(path string) *FileObjectStore {
+	return &FileObjectStore{
+		MemoryObjectStore: NewMemoryObjectStore(),
+		path:              path,
+		done:              make(chan struct{}),
+	}
+}
+
+func (fs *FileObjectStore) Init() error {
+	dir := filepath.Dir(fs.path)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	if _, err := os.Stat(fs.path); !os.IsNotExist(err) {
+		if err := fs.loadFromFile(); err != nil {
+			return err
+		}
+	}
+
+	fs.saveTicker = time.NewTicker(saveInterval)
+	go fs.periodicSave()
+	return nil
+}
+
+func (fs *FileObjectStore) Set(key string, value []byte) error {
+	if !json.Valid(value) {
+		return errors.New("value must be valid json")
+	}
+	if err := fs.MemoryObjectStore.Set(key, value); err != nil {
+		return err
+	}
+	fs.markDirty()
+	return nil
+}
+
+func (fs *FileObjectStore) Del(key string) error {
+	if err := fs.MemoryObjectStore.Del(key); err != nil {
+		return err
+	}
+	fs.markDirty()
+	return nil
+}
+
+func (fs *FileObjectStore) Close() error {
+	if fs.saveTicker != nil {
+		fs.saveTicker.Stop()
+		close(fs.done)
+	}
+	return fs.saveToFile()
+}
+
+func (fs *FileObjectStore) markDirty() {
+	fs.dirtyMutex.Lock()
+	fs.dirty = true
+	fs.dirtyMutex.Unlock()
+}
+
+func (fs *FileObjectStore) loadFromFile() error {
+	data, err := ioutil.ReadFile(fs.path)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	var objects map[string]json.RawMessage
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	for key, value := range objects {
+		fs.data[key] = value
+	}
+	return nil
+}
+
+func (fs *FileObjectStore) saveToFile() error {
+	fs.dirtyMutex.Lock()
+	defer fs.dirtyMutex.Unlock()
+	if !fs.dirty {
+		return nil
+	}
+
+	fs.mutex.RLock()
+	objects := make(map[string]json.RawMessage, len(fs.data))
+	for key, value := range fs.data {
+		objects[key] = value
+	}
+	fs.mutex.RUnlock()
+
+	data, err := json.Marshal(objects)
+	if err != nil {
+		return err
+	}
+
+	// 先写入临时文件再重命名，避免写入过程中异常退出导致文件损坏
+	tmp := fs.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, fs.path); err != nil {
+		return err
+	}
+
+	fs.dirty = false
+	return nil
+}
+
+func (fs *FileObjectStore) periodicSave() {
+	for {
+		select {
+		case <-fs.done:
+			return
+		case <-fs.saveTicker.C:
+			if err := fs.saveToFile(); err != nil {
+				log.Printf("failed to save objects to file: %v", err)
+			}
+		}
+	}
+}
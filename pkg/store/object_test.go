@@ -0,0 +1,59 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestFileObjectStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "objects.json")
+	fs := NewFileObjectStore(path)
+	if err := fs.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// Test Set and Get methods
+	if err := fs.Set("a/1", []byte(`{"id":1}`)); err != nil {
+		t.Errorf("Set failed: %v", err)
+	}
+	if err := fs.Set("b/1", []byte(`{"id":2}`)); err != nil {
+		t.Errorf("Set failed: %v", err)
+	}
+	if err := fs.Set("a/2", []byte(`invalid`)); err == nil {
+		t.Errorf("Set failed: expected error for invalid json")
+	}
+	if value, ok := fs.Get("a/1"); !ok || string(value) != `{"id":1}` {
+		t.Errorf("Get failed: expected {\"id\":1} but got %s", value)
+	}
+
+	// Test Range method
+	var keys []string
+	fs.Range("a/", func(key string, value []byte) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if len(keys) != 1 || keys[0] != "a/1" {
+		t.Errorf("Range failed: expected [a/1] but got %v", keys)
+	}
+
+	// Test data is reloaded from file
+	if err := fs.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	reloaded := NewFileObjectStore(path)
+	if err := reloaded.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer reloaded.Close()
+	if value, ok := reloaded.Get("b/1"); !ok || string(value) != `{"id":2}` {
+		t.Errorf("Reload failed: expected {\"id\":2} but got %s", value)
+	}
+
+	// Test Del method
+	if err := reloaded.Del("b/1"); err != nil {
+		t.Errorf("Del failed: %v", err)
+	}
+	if _, ok := reloaded.Get("b/1"); ok {
+		t.Errorf("Del failed: expected key to be deleted")
+	}
+}
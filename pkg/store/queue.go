@@ -0,0 +1,367 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// fsync 策略
+const (
+	// FsyncAlways 每次写入后立即 fsync，进程或系统崩溃都不会丢失已确认写入的数据
+	FsyncAlways = "always"
+	// FsyncInterval 每秒 fsync 一次，系统崩溃时最多丢失最近一秒写入的数据
+	FsyncInterval = "interval"
+	// FsyncNever 由操作系统决定何时写入磁盘，只能保证进程崩溃时不丢失数据
+	FsyncNever = "never"
+)
+
+const (
+	fsyncInterval = time.Second
+	// compactThreshold 已确认的记录超过该数量且多于未确认的记录时重写日志文件
+	compactThreshold = 1000
+)
+
+// Queue 持久化的任务队列，任务写入后直到调用 Ack 前都会保留，
+// 重启后通过 Pending 取回未确认的任务，保证任务至少被处理一次
+type Queue interface {
+	Init() error
+	// Enqueue 写入任务，返回时任务已按 fsync 策略持久化
+	Enqueue(id string, value []byte) error
+	// Ack 确认任务已处理完成
+	Ack(id string) error
+	// Pending 按写入顺序返回所有未确认的任务
+	Pending() []QueueEntry
+	Close() error
+}
+
+// QueueEntry 队列中的任务
+type QueueEntry struct {
+	ID    string          `json:"id"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// NewQueue 根据存储类型创建 Queue，
+// file 类型的日志保存在 path 所在目录下的 name.wal 文件中
+func NewQueue(typ, path, name, fsync string) (Queue, error) {
+	switch typ {
+	case "memory":
+		return NewMemoryQueue(), nil
+	case "file":
+		if path == "" {
+			path = defaultPath
+		}
+		return NewFileQueue(filepath.Join(filepath.Dir(path), name+".wal"), fsync)
+	default:
+		return nil, errors.New("can't find storage driver")
+	}
+}
+
+// MemoryQueue 只在内存中保存任务，重启后任务会丢失
+type MemoryQueue struct {
+	mutex   sync.Mutex
+	seq     uint64
+	entries map[string]queueEntry
+}
+
+type queueEntry struct {
+	seq   uint64
+	value []byte
+}
+
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{
+		entries: make(map[string]queueEntry),
+	}
+}
+
+func (q *MemoryQueue) Init() error {
+	return nil
+}
+
+func (q *MemoryQueue) Enqueue(id string, value []byte) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.seq++
+	q.entries[id] = queueEntry{seq: q.seq, value: value}
+	return nil
+}
+
+func (q *MemoryQueue) Ack(id string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	delete(q.entries, id)
+	return nil
+}
+
+func (q *MemoryQueue) Pending() []QueueEntry {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return sortedEntries(q.entries)
+}
+
+func (q *MemoryQueue) Close() error {
+	return nil
+}
+
+// walRecord 日志文件中的一行记录
+type walRecord struct {
+	Op    string          `json:"op"`
+	ID    string          `json:"id"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+const (
+	walOpEnqueue = "enqueue"
+	walOpAck     = "ack"
+)
+
+// FileQueue 以追加写入的日志文件（每行一条 json 记录）保存任务，
+// 启动时重放日志得到未确认的任务，已确认的记录较多时重写日志文件
+type FileQueue struct {
+	mutex   sync.Mutex
+	path    string
+	fsync   string
+	file    *os.File
+	seq     uint64
+	entries map[string]queueEntry
+	acked   int
+	dirty   bool
+	done    chan struct{}
+}
+
+func NewFileQueue(path, fsync string) (*FileQueue, error) {
+	switch fsync {
+	case "":
+		fsync = FsyncAlways
+	case FsyncAlways, FsyncInterval, FsyncNever:
+	default:
+		return nil, fmt.Errorf("invalid fsync policy: %s", fsync)
+	}
+	return &FileQueue{
+		path:    path,
+		fsync:   fsync,
+		entries: make(map[string]queueEntry),
+		done:    make(chan struct{}),
+	}, nil
+}
+
+func (q *FileQueue) Init() error {
+	dir := filepath.Dir(q.path)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	if err := q.replay(); err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	// 启动时重写日志，只保留未确认的任务
+	if err := q.compact(); err != nil {
+		return err
+	}
+
+	if q.fsync == FsyncInterval {
+		go q.periodicSync()
+	}
+	return nil
+}
+
+func (q *FileQueue) Enqueue(id string, value []byte) error {
+	if !json.Valid(value) {
+		return errors.New("value must be valid json")
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if err := q.write(walRecord{Op: walOpEnqueue, ID: id, Value: value}); err != nil {
+		return err
+	}
+	q.seq++
+	q.entries[id] = queueEntry{seq: q.seq, value: value}
+	return nil
+}
+
+func (q *FileQueue) Ack(id string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if _, ok := q.entries[id]; !ok {
+		return nil
+	}
+	if err := q.write(walRecord{Op: walOpAck, ID: id}); err != nil {
+		return err
+	}
+	delete(q.entries, id)
+
+	q.acked++
+	if q.acked > compactThreshold && q.acked > len(q.entries) {
+		if err := q.compact(); err != nil {
+			log.Printf("failed to compact queue file: %v", err)
+		}
+	}
+	return nil
+}
+
+func (q *FileQueue) Pending() []QueueEntry {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return sortedEntries(q.entries)
+}
+
+func (q *FileQueue) Close() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.file == nil {
+		return nil
+	}
+	close(q.done)
+	if err := q.file.Sync(); err != nil {
+		return err
+	}
+	err := q.file.Close()
+	q.file = nil
+	return err
+}
+
+// replay 重放日志文件，进程崩溃时最后一行可能只写入了一部分，忽略无法解析的记录
+func (q *FileQueue) replay() error {
+	f, err := os.Open(q.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var record walRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			log.Printf("skip corrupted queue record: %v", err)
+			continue
+		}
+		switch record.Op {
+		case walOpEnqueue:
+			q.seq++
+			q.entries[record.ID] = queueEntry{seq: q.seq, value: record.Value}
+		case walOpAck:
+			delete(q.entries, record.ID)
+		}
+	}
+	return scanner.Err()
+}
+
+// compact 将未确认的任务写入临时文件后替换日志文件，调用方需持有锁
+func (q *FileQueue) compact() error {
+	tmp := q.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	for _, entry := range sortedEntries(q.entries) {
+		data, err := json.Marshal(walRecord{Op: walOpEnqueue, ID: entry.ID, Value: entry.Value})
+		if err != nil {
+			f.Close()
+			return err
+		}
+		w.Write(append(data, '\n'))
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, q.path); err != nil {
+		return err
+	}
+
+	if q.file != nil {
+		q.file.Close()
+	}
+	q.file, err = os.OpenFile(q.path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	q.acked = 0
+	return nil
+}
+
+// write 追加一条记录，调用方需持有锁
+func (q *FileQueue) write(record walRecord) error {
+	if q.file == nil {
+		return errors.New("queue is closed")
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := q.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if q.fsync == FsyncAlways {
+		return q.file.Sync()
+	}
+	q.dirty = true
+	return nil
+}
+
+func (q *FileQueue) periodicSync() {
+	ticker := time.NewTicker(fsyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-ticker.C:
+			q.mutex.Lock()
+			if q.dirty && q.file != nil {
+				if err := q.file.Sync(); err != nil {
+					log.Printf("failed to sync queue file: %v", err)
+				}
+				q.dirty = false
+			}
+			q.mutex.Unlock()
+		}
+	}
+}
+
+// sortedEntries 按写入顺序返回任务
+func sortedEntries(entries map[string]queueEntry) []QueueEntry {
+	ids := make([]string, 0, len(entries))
+	for id := range entries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return entries[ids[i]].seq < entries[ids[j]].seq
+	})
+
+	result := make([]QueueEntry, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, QueueEntry{ID: id, Value: entries[id].value})
+	}
+	return result
+}
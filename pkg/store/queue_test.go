@@ -0,0 +1,67 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileQueue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.wal")
+	q, err := NewFileQueue(path, FsyncAlways)
+	if err != nil {
+		t.Fatalf("NewFileQueue failed: %v", err)
+	}
+	if err := q.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// Test Enqueue and Ack methods
+	for _, id := range []string{"a", "b", "c"} {
+		if err := q.Enqueue(id, []byte(`{"id":"`+id+`"}`)); err != nil {
+			t.Errorf("Enqueue failed: %v", err)
+		}
+	}
+	if err := q.Enqueue("d", []byte(`invalid`)); err == nil {
+		t.Errorf("Enqueue failed: expected error for invalid json")
+	}
+	if err := q.Ack("b"); err != nil {
+		t.Errorf("Ack failed: %v", err)
+	}
+	if pending := q.Pending(); len(pending) != 2 || pending[0].ID != "a" || pending[1].ID != "c" {
+		t.Errorf("Pending failed: expected [a c] but got %v", pending)
+	}
+
+	// Simulate a crash in the middle of writing the last record
+	if err := q.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("OpenFile failed: %v", err)
+	}
+	f.WriteString(`{"op":"ack","id":`)
+	f.Close()
+
+	// Test pending entries are replayed in order
+	reloaded, _ := NewFileQueue(path, FsyncInterval)
+	if err := reloaded.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer reloaded.Close()
+	pending := reloaded.Pending()
+	if len(pending) != 2 || pending[0].ID != "a" || pending[1].ID != "c" {
+		t.Fatalf("Replay failed: expected [a c] but got %v", pending)
+	}
+	if string(pending[1].Value) != `{"id":"c"}` {
+		t.Errorf("Replay failed: unexpected value %s", pending[1].Value)
+	}
+	if err := reloaded.Enqueue("e", []byte(`{}`)); err != nil {
+		t.Errorf("Enqueue after replay failed: %v", err)
+	}
+
+	// Test invalid fsync policy
+	if _, err := NewFileQueue(path, "sometimes"); err == nil {
+		t.Errorf("NewFileQueue failed: expected error for invalid fsync policy")
+	}
+}